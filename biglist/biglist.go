@@ -0,0 +1,188 @@
+/*
+Package biglist provides a List that keeps at most a fixed number of elements in memory
+and transparently spills the others to temporary files, so datasets larger than the available memory
+can be processed sequentially with the same Fold and Map vocabulary as list.List.
+Elements are written with encoding/gob, so T must be encodable by gob.
+*/
+package biglist
+
+import (
+	"encoding/gob"
+	"errors"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+	"os"
+)
+
+/*
+List is an append-only list holding at most budget elements in memory.
+When the budget is reached, the elements in memory are written to a new temporary chunk file.
+Unlike list.List it is mutable and not safe for concurrent use. Close must be called to remove the chunk files.
+*/
+type List[T any] struct {
+	dir    string
+	budget int
+	chunks []string
+	memory []T
+	length int
+}
+
+/*
+New creates an empty List keeping at most budget elements in memory, spilling to the default temporary directory.
+A budget lower than 1 is treated as 1.
+Example: New[int](100000) returns a List keeping at most 100000 integers in memory.
+*/
+func New[T any](budget int) *List[T] {
+	return NewIn[T]("", budget)
+}
+
+/*
+NewIn works like New but writes the chunk files to the given directory.
+An empty directory means the default temporary directory.
+*/
+func NewIn[T any](dir string, budget int) *List[T] {
+	if budget < 1 {
+		budget = 1
+	}
+	return &List[T]{
+		dir:    dir,
+		budget: budget,
+		memory: make([]T, 0, budget),
+	}
+}
+
+/*
+Len returns the number of elements of the list, in memory and on disk.
+*/
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+/*
+Append adds the given values at the end of the list, spilling to disk whenever the memory budget is reached.
+If a chunk cannot be written, its file is removed and Append returns the error: the elements in memory are kept,
+and the values that were not appended yet are dropped, so the list stays consistent.
+*/
+func (l *List[T]) Append(values ...T) error {
+	for _, v := range values {
+		if len(l.memory) == l.budget {
+			if err := l.spill(); err != nil {
+				return err
+			}
+		}
+		l.memory = append(l.memory, v)
+		l.length++
+	}
+	return nil
+}
+
+// spill writes the elements in memory to a new chunk file, and only records the chunk once it is completely written.
+func (l *List[T]) spill() error {
+	file, err := os.CreateTemp(l.dir, "biglist-*.gob")
+	if err != nil {
+		return err
+	}
+	err = gob.NewEncoder(file).Encode(l.memory)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(file.Name()))
+	}
+	l.chunks = append(l.chunks, file.Name())
+	l.memory = l.memory[:0]
+	return nil
+}
+
+/*
+Close removes the chunk files of the list. The list must not be used afterwards.
+*/
+func (l *List[T]) Close() error {
+	var errs []error
+	for _, chunk := range l.chunks {
+		if err := os.Remove(chunk); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	l.chunks = nil
+	l.memory = nil
+	l.length = 0
+	return errors.Join(errs...)
+}
+
+/*
+ForEach applies f to every element of the list in order, reading the chunk files back one at a time.
+It returns the first error met while reading a chunk.
+*/
+func ForEach[T any](l *List[T], f func(T)) error {
+	for _, chunk := range l.chunks {
+		values, err := readChunk[T](chunk)
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			f(v)
+		}
+	}
+	for _, v := range l.memory {
+		f(v)
+	}
+	return nil
+}
+
+func (l *List[T]) ForEach(f func(T)) error {
+	return ForEach(l, f)
+}
+
+func readChunk[T any](chunk string) ([]T, error) {
+	file, err := os.Open(chunk)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var values []T
+	err = gob.NewDecoder(file).Decode(&values)
+	return values, err
+}
+
+/*
+Fold applies a function to the elements of the list in a cumulative way, starting from the given root value.
+It fails if a chunk file cannot be read back.
+Example: Fold(l, 0, func(a int, b int) int { return a + b }) returns Success[int](sum of the elements)
+*/
+func Fold[T any, R any](l *List[T], root R, f func(R, T) R) try.Try[R] {
+	result := root
+	err := ForEach(l, func(t T) {
+		result = f(result, t)
+	})
+	return try.Pure(result, err)
+}
+
+/*
+Map applies a function to each element of the list and returns a new List, with the same memory budget and directory,
+containing the results. The returned List must be closed as well.
+*/
+func Map[T any, R any](l *List[T], f func(T) R) try.Try[*List[R]] {
+	results := NewIn[R](l.dir, l.budget)
+	var err error
+	readErr := ForEach(l, func(t T) {
+		if err == nil {
+			err = results.Append(f(t))
+		}
+	})
+	if err = errors.Join(readErr, err); err != nil {
+		return try.Fail[*List[R]](errors.Join(err, results.Close()))
+	}
+	return try.Success(results)
+}
+
+/*
+ToList loads every element of the list in memory and returns them as a list.List.
+*/
+func ToList[T any](l *List[T]) try.Try[list.List[T]] {
+	values := make([]T, 0, l.length)
+	err := ForEach(l, func(t T) {
+		values = append(values, t)
+	})
+	return try.Pure(list.Pure(values), err)
+}
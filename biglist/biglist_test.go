@@ -0,0 +1,50 @@
+package biglist
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSpillFailureKeepsElements(t *testing.T) {
+	dir := t.TempDir()
+	l := NewIn[func()](dir, 2)
+	defer l.Close()
+	if err := l.Append(func() {}, func() {}); err != nil {
+		t.Fatalf("Append within the budget fails: %v", err)
+	}
+	if err := l.Append(func() {}); err == nil {
+		t.Fatal("Append spilling values gob cannot encode succeeds, want an error")
+	}
+	if l.Len() != 2 || len(l.memory) != 2 || len(l.chunks) != 0 {
+		t.Errorf("after a failed spill: Len %d, %d in memory, %d chunks, want 2, 2 and 0", l.Len(), len(l.memory), len(l.chunks))
+	}
+	count := 0
+	if err := l.ForEach(func(func()) { count++ }); err != nil || count != 2 {
+		t.Errorf("ForEach after a failed spill visits %d elements with error %v, want 2 and no error", count, err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 0 {
+		t.Errorf("the chunk directory holds %d files after a failed spill, want none", len(entries))
+	}
+}
+
+func TestSpill(t *testing.T) {
+	dir := t.TempDir()
+	l := NewIn[int](dir, 2)
+	if err := l.Append(1, 2, 3, 4, 5); err != nil {
+		t.Fatalf("Append fails: %v", err)
+	}
+	if len(l.chunks) != 2 || l.Len() != 5 {
+		t.Errorf("Append(1, 2, 3, 4, 5) with a budget of 2 leaves %d chunks and Len %d, want 2 and 5", len(l.chunks), l.Len())
+	}
+	var got []int
+	if err := l.ForEach(func(v int) { got = append(got, v) }); err != nil || len(got) != 5 || got[4] != 5 {
+		t.Errorf("ForEach visits %v with error %v, want [1 2 3 4 5]", got, err)
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("Close fails: %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Close leaves %d chunk files, want none", len(entries))
+	}
+}
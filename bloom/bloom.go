@@ -0,0 +1,155 @@
+package bloom
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Sugther/go-structs/either"
+	"github.com/Sugther/go-structs/hash"
+	"math"
+	"math/bits"
+)
+
+// ErrIncompatible is returned when combining Filters that were not created with the same parameters.
+var ErrIncompatible = errors.New("bloom: incompatible filters")
+
+/*
+Filter is an immutable Bloom filter over values of type T: a compact probabilistic set telling that a value
+is certainly absent or might be present. Values are hashed with hash.Of, so values equal according to equal.Equals
+are found in the Filter.
+It is meant to pre-filter expensive lookups in Sets or external stores over very large key spaces.
+Like the other containers, Add returns a new Filter: adding many values at once copies the bits only once.
+*/
+type Filter[T any] struct {
+	bits   []uint64
+	size   uint64
+	hashes int
+}
+
+/*
+New creates a new empty Filter sized to hold the expected number of values with the given false positive rate.
+It panics if expected is not positive or if falsePositiveRate is not strictly between 0 and 1.
+Example: New[string](1000, 0.01) returns a Filter of 9586 bits using 7 hash functions
+*/
+func New[T any](expected int, falsePositiveRate float64) Filter[T] {
+	if expected <= 0 {
+		panic("bloom: New with a non-positive expected number of values")
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic("bloom: New with a false positive rate not between 0 and 1")
+	}
+	size := uint64(math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := int(math.Round(float64(size) / float64(expected) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return Filter[T]{bits: make([]uint64, (size+63)/64), size: size, hashes: hashes}
+}
+
+/*
+Add returns a new Filter to which the given values are added. It panics if the Filter was not created with New.
+Example: New[string](1000, 0.01).Add("a", "b").MightContain("a") returns true
+*/
+func Add[T any](filter Filter[T], values ...T) Filter[T] {
+	if filter.size == 0 {
+		panic("bloom: Add to a Filter not created with New")
+	}
+	result := filter
+	result.bits = append([]uint64(nil), filter.bits...)
+	for _, v := range values {
+		result.each(v, func(bit uint64) bool {
+			result.bits[bit/64] |= 1 << (bit % 64)
+			return true
+		})
+	}
+	return result
+}
+
+func (filter Filter[T]) Add(values ...T) Filter[T] {
+	return Add(filter, values...)
+}
+
+/*
+MightContain returns false if the given value was certainly not added to the Filter,
+and true if it was added or, with a probability given by FalsePositiveRate, if it was not.
+Examples:
+New[int](100, 0.01).Add(1).MightContain(1) returns true
+New[int](100, 0.01).MightContain(1) returns false
+*/
+func MightContain[T any](filter Filter[T], value T) bool {
+	if filter.size == 0 {
+		return false
+	}
+	return filter.each(value, func(bit uint64) bool {
+		return filter.bits[bit/64]&(1<<(bit%64)) != 0
+	})
+}
+
+func (filter Filter[T]) MightContain(value T) bool {
+	return MightContain(filter, value)
+}
+
+/*
+FalsePositiveRate returns the estimated probability that MightContain returns true for a value that was not added,
+computed from the proportion of bits set in the Filter.
+Examples:
+New[int](100, 0.01).FalsePositiveRate() returns 0
+New[int](100, 0.01).Add(1).FalsePositiveRate() returns about 1e-15
+*/
+func FalsePositiveRate[T any](filter Filter[T]) float64 {
+	if filter.size == 0 {
+		return 0
+	}
+	set := 0
+	for _, word := range filter.bits {
+		set += bits.OnesCount64(word)
+	}
+	return math.Pow(float64(set)/float64(filter.size), float64(filter.hashes))
+}
+
+func (filter Filter[T]) FalsePositiveRate() float64 {
+	return FalsePositiveRate(filter)
+}
+
+/*
+Union returns a new Filter wrapped in an Either, in which the values added to either Filter might be contained.
+It returns ErrIncompatible if the Filters were not created with the same parameters.
+Examples:
+Union(New[int](100, 0.01).Add(1), New[int](100, 0.01).Add(2)) returns Right(Filter[int]) containing 1 and 2
+Union(New[int](100, 0.01), New[int](10, 0.01)) returns Left(ErrIncompatible)
+*/
+func Union[T any](filter1 Filter[T], filter2 Filter[T]) either.Either[error, Filter[T]] {
+	if filter1.size != filter2.size || filter1.hashes != filter2.hashes {
+		return either.Left[error, Filter[T]](fmt.Errorf("%w: %d bits and %d hash functions, %d bits and %d hash functions",
+			ErrIncompatible, filter1.size, filter1.hashes, filter2.size, filter2.hashes))
+	}
+	result := filter1
+	result.bits = make([]uint64, len(filter1.bits))
+	for i := range result.bits {
+		result.bits[i] = filter1.bits[i] | filter2.bits[i]
+	}
+	return either.Right[error](result)
+}
+
+func (filter Filter[T]) Union(filter2 Filter[T]) either.Either[error, Filter[T]] {
+	return Union(filter, filter2)
+}
+
+/*
+String returns a readable representation of the Filter, implementing fmt.Stringer.
+Example: New[int](100, 0.01).String() returns "Filter(959 bits, 7 hashes)"
+*/
+func (filter Filter[T]) String() string {
+	return fmt.Sprintf("Filter(%d bits, %d hashes)", filter.size, filter.hashes)
+}
+
+// each calls f with the bits of value, using double hashing, until f returns false. It returns false if f did.
+func (filter Filter[T]) each(value T, f func(bit uint64) bool) bool {
+	h1 := hash.Of(value)
+	h2 := hash.Combine(h1) | 1
+	for i := 0; i < filter.hashes; i++ {
+		if !f((h1 + uint64(i)*h2) % filter.size) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,199 @@
+/*
+Package concurrent provides List and Set wrappers that can be shared between goroutines.
+Since the wrapped collections are immutable, reads only hold the lock long enough to take a snapshot,
+and Update replaces the snapshot atomically.
+*/
+package concurrent
+
+import (
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/set"
+	"sync"
+)
+
+/*
+List is a list.List safe for concurrent use. The zero value is an empty List ready to use.
+A List must not be copied after first use.
+*/
+type List[T any] struct {
+	mutex sync.RWMutex
+	list  list.List[T]
+}
+
+/*
+NewList creates a new List holding the given list.
+Example: NewList(list.Of(1, 2, 3)) returns a *List[int] holding List[int]([1,2,3])
+*/
+func NewList[T any](l list.List[T]) *List[T] {
+	return &List[T]{list: l}
+}
+
+/*
+Load returns the list currently held. Later updates do not affect the returned list.
+Example: NewList(list.Of(1, 2, 3)).Load() returns List[int]([1,2,3])
+*/
+func (l *List[T]) Load() list.List[T] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.list
+}
+
+/*
+Store replaces the list currently held.
+Example: l.Store(list.Of(4)) makes l hold List[int]([4])
+*/
+func (l *List[T]) Store(value list.List[T]) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.list = value
+}
+
+/*
+Update atomically replaces the list currently held with the result of f applied to it, and returns that result.
+No other update can happen between the call to f and the replacement, so f must not use l itself.
+Example: NewList(list.Of(1, 2)).Update(func(l list.List[int]) list.List[int] { return l.Append(3) }) returns List[int]([1,2,3])
+*/
+func (l *List[T]) Update(f func(list.List[T]) list.List[T]) list.List[T] {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.list = f(l.list)
+	return l.list
+}
+
+func (l *List[T]) Len() int {
+	return l.Load().Len()
+}
+
+func (l *List[T]) IsEmpty() bool {
+	return l.Load().IsEmpty()
+}
+
+func (l *List[T]) NonEmpty() bool {
+	return l.Load().NonEmpty()
+}
+
+func (l *List[T]) Head() option.Option[T] {
+	return l.Load().Head()
+}
+
+func (l *List[T]) Last() option.Option[T] {
+	return l.Load().Last()
+}
+
+func (l *List[T]) Get(index int) option.Option[T] {
+	return l.Load().Get(index)
+}
+
+func (l *List[T]) Find(f func(T) bool) option.Option[T] {
+	return l.Load().Find(f)
+}
+
+func (l *List[T]) AnyMatch(f func(T) bool) bool {
+	return l.Load().AnyMatch(f)
+}
+
+func (l *List[T]) Forall(f func(T) bool) bool {
+	return l.Load().Forall(f)
+}
+
+func (l *List[T]) ContainsAll(values ...T) bool {
+	return l.Load().ContainsAll(values...)
+}
+
+func (l *List[T]) ContainsAny(values ...T) bool {
+	return l.Load().ContainsAny(values...)
+}
+
+func (l *List[T]) String() string {
+	return l.Load().String()
+}
+
+/*
+Set is a set.Set safe for concurrent use. The zero value is an empty Set ready to use.
+A Set must not be copied after first use.
+*/
+type Set[T any] struct {
+	mutex sync.RWMutex
+	set   set.Set[T]
+}
+
+/*
+NewSet creates a new Set holding the given set.
+Example: NewSet(set.Of(1, 2, 3)) returns a *Set[int] holding Set[int]([1,2,3])
+*/
+func NewSet[T any](s set.Set[T]) *Set[T] {
+	return &Set[T]{set: s}
+}
+
+/*
+Load returns the set currently held. Later updates do not affect the returned set.
+Example: NewSet(set.Of(1, 2, 3)).Load() returns Set[int]([1,2,3])
+*/
+func (s *Set[T]) Load() set.Set[T] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set
+}
+
+/*
+Store replaces the set currently held.
+Example: s.Store(set.Of(4)) makes s hold Set[int]([4])
+*/
+func (s *Set[T]) Store(value set.Set[T]) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set = value
+}
+
+/*
+Update atomically replaces the set currently held with the result of f applied to it, and returns that result.
+No other update can happen between the call to f and the replacement, so f must not use s itself.
+Example: NewSet(set.Of(1, 2)).Update(func(s set.Set[int]) set.Set[int] { return s.Append(2, 3) }) returns Set[int]([1,2,3])
+*/
+func (s *Set[T]) Update(f func(set.Set[T]) set.Set[T]) set.Set[T] {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set = f(s.set)
+	return s.set
+}
+
+func (s *Set[T]) Len() int {
+	return s.Load().Len()
+}
+
+func (s *Set[T]) IsEmpty() bool {
+	return s.Load().IsEmpty()
+}
+
+func (s *Set[T]) NonEmpty() bool {
+	return s.Load().NonEmpty()
+}
+
+func (s *Set[T]) Contains(value T) bool {
+	return set.Contains(s.Load(), value)
+}
+
+func (s *Set[T]) Find(f func(T) bool) option.Option[T] {
+	return s.Load().Find(f)
+}
+
+func (s *Set[T]) AnyMatch(f func(T) bool) bool {
+	return s.Load().AnyMatch(f)
+}
+
+func (s *Set[T]) Forall(f func(T) bool) bool {
+	return s.Load().Forall(f)
+}
+
+func (s *Set[T]) ContainsAll(values ...T) bool {
+	return s.Load().ContainsAll(values...)
+}
+
+func (s *Set[T]) ContainsAny(values ...T) bool {
+	return s.Load().ContainsAny(values...)
+}
+
+func (s *Set[T]) String() string {
+	return s.Load().String()
+}
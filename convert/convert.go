@@ -0,0 +1,151 @@
+package convert
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/Sugther/go-structs/constraints"
+	"github.com/Sugther/go-structs/either"
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/try"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+/*
+ToIntOption parses a base 10 integer and returns it wrapped in an Option.
+If the string is not a valid int, it returns an empty Option.
+Examples:
+ToIntOption("42") returns Option[int](42)
+ToIntOption("4.2") returns Option[int]{isPresent: false}
+*/
+func ToIntOption(s string) option.Option[int] {
+	return either.ToOption(ParseIntEither(s))
+}
+
+/*
+ToFloatOption parses a 64-bit floating point number and returns it wrapped in an Option.
+If the string is not a valid float, it returns an empty Option.
+Examples:
+ToFloatOption("4.2") returns Option[float64](4.2)
+ToFloatOption("abc") returns Option[float64]{isPresent: false}
+*/
+func ToFloatOption(s string) option.Option[float64] {
+	return either.ToOption(ParseFloatEither(s))
+}
+
+/*
+ToBoolOption parses a boolean as accepted by strconv.ParseBool and returns it wrapped in an Option.
+If the string is not a valid boolean, it returns an empty Option.
+Examples:
+ToBoolOption("true") returns Option[bool](true)
+ToBoolOption("yes") returns Option[bool]{isPresent: false}
+*/
+func ToBoolOption(s string) option.Option[bool] {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return option.Empty[bool]()
+	}
+	return option.Pure(b)
+}
+
+/*
+ParseIntEither parses a base 10 integer and returns it as a Right,
+or the strconv error as a Left if the string is not a valid int.
+Examples:
+ParseIntEither("42") returns Right[error, int](42)
+ParseIntEither("abc") returns Left[error, int](strconv.ErrSyntax wrapped in a *strconv.NumError)
+*/
+func ParseIntEither(s string) either.Either[error, int] {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return either.Left[error, int](err)
+	}
+	return either.Right[error](n)
+}
+
+/*
+ParseFloatEither parses a 64-bit floating point number and returns it as a Right,
+or the strconv error as a Left if the string is not a valid float.
+Examples:
+ParseFloatEither("4.2") returns Right[error, float64](4.2)
+ParseFloatEither("abc") returns Left[error, float64](strconv.ErrSyntax wrapped in a *strconv.NumError)
+*/
+func ParseFloatEither(s string) either.Either[error, float64] {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return either.Left[error, float64](err)
+	}
+	return either.Right[error](f)
+}
+
+/*
+Integer converts an integer to another integer type and returns it wrapped in an Option.
+If the value does not fit in the target type, it returns an empty Option instead of silently wrapping around.
+Examples:
+Integer[int8](int64(100)) returns Option[int8](100)
+Integer[int8](int64(300)) returns Option[int8]{isPresent: false}
+Integer[uint](-1) returns Option[uint]{isPresent: false}
+*/
+func Integer[To constraints.Integer, From constraints.Integer](value From) option.Option[To] {
+	converted := To(value)
+	if From(converted) != value || (converted < 0) != (value < 0) {
+		return option.Empty[To]()
+	}
+	return option.Pure(converted)
+}
+
+/*
+ParseTime parses a time with the given layout as time.Parse does and returns it wrapped in a Try.
+Examples:
+ParseTime(time.DateOnly, "2024-01-31") returns Success(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+ParseTime(time.DateOnly, "31/01/2024") returns Fail[time.Time](*time.ParseError)
+*/
+func ParseTime(layout string, s string) try.Try[time.Time] {
+	return try.Pure(time.Parse(layout, s))
+}
+
+/*
+ParseDuration parses a duration such as "1h30m" as time.ParseDuration does and returns it wrapped in a Try.
+Examples:
+ParseDuration("1h30m") returns Success(90 * time.Minute)
+ParseDuration("soon") returns Fail[time.Duration](error)
+*/
+func ParseDuration(s string) try.Try[time.Duration] {
+	return try.Pure(time.ParseDuration(s))
+}
+
+/*
+ParseURL parses a URL as url.Parse does and returns it wrapped in a Try.
+Examples:
+ParseURL("https://example.com/path") returns Success(&url.URL{Scheme: "https", Host: "example.com", Path: "/path"})
+ParseURL("http://[::1") returns Fail[*url.URL](*url.Error)
+*/
+func ParseURL(s string) try.Try[*url.URL] {
+	return try.Pure(url.Parse(s))
+}
+
+/*
+ErrInvalidUUID is the error wrapped by the failures of ParseUUID.
+*/
+var ErrInvalidUUID = errors.New("convert: invalid UUID")
+
+/*
+ParseUUID parses a UUID in its canonical textual form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, in either case,
+and returns its 16 bytes wrapped in a Try. The version and variant bits are not checked.
+Examples:
+ParseUUID("123e4567-e89b-12d3-a456-426614174000") returns Success([16]byte{0x12, 0x3e, ..., 0x00})
+ParseUUID("123e4567") returns Fail[[16]byte](ErrInvalidUUID)
+*/
+func ParseUUID(s string) try.Try[[16]byte] {
+	var uuid [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return try.Fail[[16]byte](fmt.Errorf("%w: %q", ErrInvalidUUID, s))
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+		return try.Fail[[16]byte](fmt.Errorf("%w: %q: %v", ErrInvalidUUID, s, err))
+	}
+	return try.Success(uuid)
+}
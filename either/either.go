@@ -1,12 +1,16 @@
 package either
 
 import (
+	"fmt"
 	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
 	"github.com/Sugther/go-structs/option"
 )
 
 /*
 Either is a container for a value that can be one of two types Left or Right (L or R).
+The zero value holds neither a Left nor a Right value and is not valid: build Eithers with Right or Left,
+and use IsValid to detect a zero Either, for instance in a struct field left uninitialized.
 */
 type Either[L any, R any] struct {
 	Right option.Option[R]
@@ -63,6 +67,21 @@ func (either Either[L, R]) IsLeft() bool {
 	return IsLeft(either)
 }
 
+/*
+IsValid checks if the Either holds exactly one value, Left or Right, as the Eithers built by Right and Left do.
+The zero Either is not valid: it is neither Right nor Left, and Fold and BiForEach give the zero value of L to their Left function.
+Examples:
+IsValid(Right[string, int](42)) returns true
+IsValid(Either[string, int]{}) returns false.
+*/
+func IsValid[L any, R any](either Either[L, R]) bool {
+	return IsRight(either) != IsLeft(either)
+}
+
+func (either Either[L, R]) IsValid() bool {
+	return IsValid(either)
+}
+
 /*
 GetOrElse retrieves the value of type R stored within the Either.
 If the Either contains a Right value, it returns the value of type R.
@@ -77,6 +96,27 @@ func (either Either[L, R]) GetOrElse(defaultValue R) R {
 	return GetOrElse(either, defaultValue)
 }
 
+/*
+FirstRight returns the first Either of the given ones that contains a Right value.
+If none does, it returns the last one, so the Left of the final fallback is kept;
+without any argument, it returns a Left holding the zero value of L.
+Examples:
+FirstRight(Left[string, int]("no flag"), Right[string, int](1), Right[string, int](2)) returns Right[string, int](1)
+FirstRight(Left[string, int]("no flag"), Left[string, int]("no env")) returns Left[string, int]("no env").
+*/
+func FirstRight[L any, R any](eithers ...Either[L, R]) Either[L, R] {
+	for _, either := range eithers {
+		if IsRight(either) {
+			return either
+		}
+	}
+	if len(eithers) == 0 {
+		var zero L
+		return Left[L, R](zero)
+	}
+	return eithers[len(eithers)-1]
+}
+
 /*
 Fold applies one of two functions depending on the state of the Either.
 If the Either contains a Left value, it applies the fLeft function to the value.
@@ -84,12 +124,14 @@ If the Either contains a Right value, it applies the fRight function to the valu
 Examples:
 Fold(Left[string, int]("error"), func(l string) int { return len(l) }, func(r int) int { return r * 2 }) returns 5
 Fold(Right[string, int](42), func(l string) int { return len(l) }, func(r int) int { return r * 2 }) returns 84.
+A zero Either, which is not valid, is folded as a Left holding the zero value of L, with or without the `gostructs_strict` tag.
 */
 func Fold[L any, R any, T any](either Either[L, R], fLeft func(L) T, fRight func(R) T) T {
 	if IsRight(either) {
 		return fRight(either.Right.Get())
 	}
-	return fLeft(either.Left.Get())
+	var zero L
+	return fLeft(either.Left.GetOrElse(zero))
 }
 
 /*
@@ -116,6 +158,19 @@ func Map[L any, R any, T any](either Either[L, R], f func(R) T) Either[L, T] {
 	return FlatMap(either, func(r R) Either[L, T] { return Right[L, T](f(r)) })
 }
 
+/*
+Ap applies the function contained in the Right of the first Either to the Right value of the second one.
+If the first Either is a Left, it is returned; otherwise, if the second one is a Left, it is returned.
+Examples:
+Ap(Right[string](func(x int) int { return x * 2 }), Right[string](21)) returns Right(42)
+Ap(Right[string](func(x int) int { return x * 2 }), Left[string, int]("error")) returns Left("error")
+*/
+func Ap[L any, R any, T any](f Either[L, func(R) T], either Either[L, R]) Either[L, T] {
+	return FlatMap(f, func(g func(R) T) Either[L, T] {
+		return Map(either, g)
+	})
+}
+
 /*
 ForEach applies a given function f to the Right value stored in the Either if it exists.
 The function f should accept a value of type R.
@@ -187,7 +242,8 @@ func BiForEach[L any, R any](either Either[L, R], fLeft func(L), fRight func(R))
 	if IsRight(either) {
 		fRight(either.Right.Get())
 	} else {
-		fLeft(either.Left.Get())
+		var zero L
+		fLeft(either.Left.GetOrElse(zero))
 	}
 }
 
@@ -209,9 +265,73 @@ func (either Either[L, R]) ToOption() option.Option[R] {
 	return ToOption(either)
 }
 
+/*
+TraverseOption applies f to the Right value of the Either and moves the resulting Option outside of the Either.
+If f returns an empty Option, the result is empty. A Left value is kept as is inside a present Option.
+Examples:
+TraverseOption(Right[string, int](42), func(r int) option.Option[int] { return option.Pure(r * 2) }) returns Option(Right(84))
+TraverseOption(Right[string, int](42), func(r int) option.Option[int] { return option.Empty[int]() }) returns Option()
+TraverseOption(Left[string, int]("error"), func(r int) option.Option[int] { return option.Pure(r * 2) }) returns Option(Left("error"))
+*/
+func TraverseOption[L any, R any, T any](either Either[L, R], f func(R) option.Option[T]) option.Option[Either[L, T]] {
+	return BitraverseOption(either, option.Pure[L], f)
+}
+
+/*
+SequenceOption turns an Either holding an Option on its Right side into an Option holding an Either.
+Examples:
+SequenceOption(Right[string, option.Option[int]](option.Pure(42))) returns Option(Right(42))
+SequenceOption(Right[string, option.Option[int]](option.Empty[int]())) returns Option()
+SequenceOption(Left[string, option.Option[int]]("error")) returns Option(Left("error"))
+*/
+func SequenceOption[L any, R any](either Either[L, option.Option[R]]) option.Option[Either[L, R]] {
+	return TraverseOption(either, func(o option.Option[R]) option.Option[R] { return o })
+}
+
+/*
+BitraverseOption applies fLeft to the Left value or fRight to the Right value of the Either,
+and moves the resulting Option outside of the Either. If the applied function returns an empty Option, the result is empty.
+Examples:
+BitraverseOption(Left[string, int]("error"), func(l string) option.Option[int] { return option.Pure(len(l)) }, func(r int) option.Option[int] { return option.Pure(r * 2) }) returns Option(Left(5))
+BitraverseOption(Right[string, int](42), func(l string) option.Option[int] { return option.Pure(len(l)) }, func(r int) option.Option[int] { return option.Empty[int]() }) returns Option()
+*/
+func BitraverseOption[L any, R any, L2 any, R2 any](either Either[L, R], fLeft func(L) option.Option[L2], fRight func(R) option.Option[R2]) option.Option[Either[L2, R2]] {
+	return Fold(either, func(l L) option.Option[Either[L2, R2]] {
+		return option.Map(fLeft(l), Left[L2, R2])
+	}, func(r R) option.Option[Either[L2, R2]] {
+		return option.Map(fRight(r), Right[L2, R2])
+	})
+}
+
 func (either Either[L, R]) Equals(other interface{}) bool {
 	if oe, ok := other.(Either[L, R]); ok {
 		return equal.Equals(either.Right, oe.Right) && equal.Equals(either.Left, oe.Left)
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the Either consistent with Equals, implementing hash.Hashable.
+Example: Right[string](42).HashCode() == Right[string](42).HashCode() returns true
+*/
+func (either Either[L, R]) HashCode() uint64 {
+	return hash.Combine(either.Right.HashCode(), either.Left.HashCode())
+}
+
+/*
+String returns a readable representation of the Either, implementing fmt.Stringer.
+Examples:
+Right[string, int](42).String() returns "Right(42)"
+Left[string, int]("error").String() returns "Left(error)"
+Either[string, int]{}.String() returns "Invalid"
+*/
+func (either Either[L, R]) String() string {
+	if !IsValid(either) {
+		return "Invalid"
+	}
+	return Fold(either, func(l L) string {
+		return fmt.Sprintf("Left(%v)", l)
+	}, func(r R) string {
+		return fmt.Sprintf("Right(%v)", r)
+	})
+}
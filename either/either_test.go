@@ -0,0 +1,52 @@
+package either
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The zero value tests run the same way with and without the gostructs_strict tag.
+
+func TestZeroEither(t *testing.T) {
+	var zero Either[string, int]
+	if zero.IsValid() || zero.IsRight() || zero.IsLeft() {
+		t.Errorf("zero Either: IsValid %v, IsRight %v, IsLeft %v, want all false", zero.IsValid(), zero.IsRight(), zero.IsLeft())
+	}
+	if got := Fold(zero, func(l string) string { return "left " + l }, func(r int) string { return "right" }); got != "left " {
+		t.Errorf("Fold(zero Either) returns %q, want %q", got, "left ")
+	}
+	left := false
+	zero.BiForEach(func(string) { left = true }, func(int) { t.Error("BiForEach(zero Either) called fRight") })
+	if !left {
+		t.Error("BiForEach(zero Either) did not call fLeft")
+	}
+	zero.ForEach(func(int) { t.Error("ForEach(zero Either) called f") })
+	if got := zero.String(); got != "Invalid" {
+		t.Errorf("zero Either String returns %q, want %q", got, "Invalid")
+	}
+	if !zero.Equals(Either[string, int]{}) || zero.Equals(Right[string](0)) {
+		t.Error("zero Either must only equal another zero Either")
+	}
+	if _, err := json.Marshal(zero); err != nil {
+		t.Errorf("json.Marshal(zero Either) fails: %v", err)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		either Either[string, int]
+		want   bool
+	}{
+		{"Right", Right[string](1), true},
+		{"Left", Left[string, int]("e"), true},
+		{"zero", Either[string, int]{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.either.IsValid(); got != tt.want {
+				t.Errorf("%v.IsValid() returns %v, want %v", tt.either, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package either
+
+import "iter"
+
+/*
+Iter returns an iterator over the Right value of the Either: it yields the value once if it is a Right, and nothing otherwise.
+Examples:
+for v := range Iter(Right[string](1)) { fmt.Println(v) } prints 1
+for v := range Iter(Left[string, int]("error")) { fmt.Println(v) } does not print
+*/
+func Iter[L any, R any](either Either[L, R]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		ForEach(either, func(r R) { yield(r) })
+	}
+}
+
+func (either Either[L, R]) Iter() iter.Seq[R] {
+	return Iter(either)
+}
@@ -1,6 +1,11 @@
 package equal
 
-import "reflect"
+import (
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+)
 
 /*
 Equal is an interface that defines a single method `Equals`, which takes an `interface{}` value and returns a `bool`.
@@ -13,7 +18,10 @@ type Equal interface {
 /*
 Equals is a function that compares two values for equality.
 If both values implement the `Equal` interface, the function uses the `Equals` method to compare the values.
-Otherwise, the function uses the `comparableEquals` function to compare the values.
+Otherwise, the function uses the `comparableEquals` function to compare the values,
+which compares booleans, numbers and strings with ==, uses the function registered with Register for the type of the values
+if there is one, compares slices, arrays and maps element-wise with Equals when their elements may have a custom equality,
+and falls back to reflect.DeepEqual for other values.
 */
 func Equals(value1 interface{}, value2 interface{}) bool {
 	v1, okV1 := value1.(Equal)
@@ -25,15 +33,195 @@ func Equals(value1 interface{}, value2 interface{}) bool {
 }
 
 func comparableEquals(value1 interface{}, value2 interface{}) bool {
+	if isBasic(value1) && isBasic(value2) {
+		return value1 == value2
+	}
+	if f, ok := registered(value1, value2); ok {
+		return f(value1, value2)
+	}
+	if equals, ok := elementsEquals(value1, value2); ok {
+		return equals
+	}
 	return reflect.DeepEqual(value1, value2)
 }
 
+var equalType = reflect.TypeOf((*Equal)(nil)).Elem()
+
+/*
+elementsEquals compares two slices, arrays or maps of the same type element-wise with Equals,
+so that elements implementing Equal or having a registered function are not compared with reflect.DeepEqual.
+As with reflect.DeepEqual, a nil slice or map is not equal to an empty one.
+It returns false as its second result if the values are not such collections or if their elements cannot have a custom equality.
+*/
+func elementsEquals(value1 interface{}, value2 interface{}) (bool, bool) {
+	if value1 == nil || value2 == nil {
+		return false, false
+	}
+	v1, v2 := reflect.ValueOf(value1), reflect.ValueOf(value2)
+	if v1.Type() != v2.Type() {
+		return false, false
+	}
+	switch v1.Kind() {
+	case reflect.Slice, reflect.Array:
+		if !hasCustomEquality(v1.Type().Elem()) {
+			return false, false
+		}
+		if v1.Kind() == reflect.Slice && v1.IsNil() != v2.IsNil() {
+			return false, true
+		}
+		if v1.Len() != v2.Len() {
+			return false, true
+		}
+		for i := 0; i < v1.Len(); i++ {
+			if !Equals(v1.Index(i).Interface(), v2.Index(i).Interface()) {
+				return false, true
+			}
+		}
+		return true, true
+	case reflect.Map:
+		if !hasCustomEquality(v1.Type().Elem()) {
+			return false, false
+		}
+		if v1.IsNil() != v2.IsNil() || v1.Len() != v2.Len() {
+			return false, true
+		}
+		entries := v1.MapRange()
+		for entries.Next() {
+			other := v2.MapIndex(entries.Key())
+			if !other.IsValid() || !Equals(entries.Value().Interface(), other.Interface()) {
+				return false, true
+			}
+		}
+		return true, true
+	}
+	return false, false
+}
+
+// hasCustomEquality reports whether values of type t may be compared differently by Equals and by reflect.DeepEqual.
+func hasCustomEquality(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return t.Implements(equalType) || isRegistered(t)
+}
+
+/*
+Comparable reports whether Equals gives the same result as == for values of type T,
+so that they can be used as map keys, for example to remove duplicates in linear time.
+This holds for booleans, numbers, strings and channels, and for arrays and structs made only of them,
+unless they implement Equal or a function is registered for them with Register.
+Examples:
+Comparable[string]() returns true
+Comparable[*big.Int]() returns false
+*/
+func Comparable[T any]() bool {
+	return hasPlainEquality(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func hasPlainEquality(t reflect.Type) bool {
+	if t.Implements(equalType) || isRegistered(t) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.String, reflect.Chan:
+		return true
+	case reflect.Array:
+		return hasPlainEquality(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !hasPlainEquality(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isRegistered(t reflect.Type) bool {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	_, ok := registry[t]
+	return ok
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[reflect.Type]func(interface{}, interface{}) bool{}
+)
+
+/*
+Register sets the function used by Equals to compare two values of type T, replacing any previously registered one.
+It is meant for types that cannot implement Equal and for which reflect.DeepEqual gives wrong results,
+such as arbitrary-precision numbers or values holding caches. It is safe for concurrent use.
+Functions are registered by default for *big.Int, *big.Float and *big.Rat, which are equal when they hold the same number,
+and for time.Time, whose values are equal when they represent the same instant, whatever their location or monotonic clock reading.
+Example: Register(func(a decimal.Decimal, b decimal.Decimal) bool { return a.Equal(b) })
+*/
+func Register[T any](f func(T, T) bool) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry[reflect.TypeOf((*T)(nil)).Elem()] = func(value1 interface{}, value2 interface{}) bool {
+		return f(value1.(T), value2.(T))
+	}
+}
+
+// registered returns the function registered for the type of the values, if both have the same type.
+func registered(value1 interface{}, value2 interface{}) (func(interface{}, interface{}) bool, bool) {
+	if value1 == nil || value2 == nil {
+		return nil, false
+	}
+	t := reflect.TypeOf(value1)
+	if t != reflect.TypeOf(value2) {
+		return nil, false
+	}
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	f, ok := registry[t]
+	return f, ok
+}
+
+func init() {
+	Register(func(a *big.Int, b *big.Int) bool {
+		return a == b || (a != nil && b != nil && a.Cmp(b) == 0)
+	})
+	Register(func(a *big.Float, b *big.Float) bool {
+		return a == b || (a != nil && b != nil && a.Cmp(b) == 0)
+	})
+	Register(func(a *big.Rat, b *big.Rat) bool {
+		return a == b || (a != nil && b != nil && a.Cmp(b) == 0)
+	})
+	Register(func(a time.Time, b time.Time) bool {
+		return a.Equal(b)
+	})
+}
+
+/*
+isBasic reports whether the value is a boolean, a number or a string,
+for which == gives the same result as reflect.DeepEqual without walking the value.
+*/
+func isBasic(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	kind := reflect.TypeOf(value).Kind()
+	return (kind >= reflect.Bool && kind <= reflect.Complex128) || kind == reflect.String
+}
+
 /*
 IsEqual is a function that checks whether a value can be compared for equality.
-If the value implements the `Equal` interface, the function returns `true`.
+If the value implements the `Equal` interface or a function is registered for its type, the function returns `true`.
 Otherwise, the function uses reflection to check whether the type of the value is comparable, and returns `true` if it is.
 */
 func IsEqual(i interface{}) bool {
-	_, ok := i.(Equal)
-	return ok || reflect.TypeOf(i).Comparable()
+	if _, ok := i.(Equal); ok {
+		return true
+	}
+	if _, ok := registered(i, i); ok {
+		return true
+	}
+	return reflect.TypeOf(i).Comparable()
 }
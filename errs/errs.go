@@ -0,0 +1,103 @@
+package errs
+
+import (
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+)
+
+/*
+List is an error aggregating several errors, in the order they occurred.
+Its Unwrap method returns all of them, so errors.Is and errors.As look through each one, as with errors.Join.
+*/
+type List struct {
+	errors list.List[error]
+}
+
+/*
+Of returns a List holding the given non-nil errors, or nil if there is none.
+Examples:
+Of(err1, nil, err2) returns List([err1, err2])
+Of(nil) returns nil
+*/
+func Of(errs ...error) error {
+	nonNil := list.Filter(list.Of(errs...), func(err error) bool { return err != nil })
+	if nonNil.IsEmpty() {
+		return nil
+	}
+	return List{errors: nonNil}
+}
+
+/*
+Errors returns the errors held by the List.
+Example: Of(err1, err2).(List).Errors() returns List[error]([err1, err2])
+*/
+func (errs List) Errors() list.List[error] {
+	return errs.errors
+}
+
+/*
+Len returns the number of errors held by the List.
+Example: Of(err1, err2).(List).Len() returns 2
+*/
+func (errs List) Len() int {
+	return errs.errors.Len()
+}
+
+/*
+Error returns the messages of the errors, separated by newlines like errors.Join.
+Example: Of(errors.New("a"), errors.New("b")).Error() returns "a\nb"
+*/
+func (errs List) Error() string {
+	return errs.errors.Join("\n", error.Error)
+}
+
+/*
+Unwrap returns the errors held by the List, implementing the multiple errors wrapping of Go 1.20.
+Example: errors.Is(Of(err1, err2), err2) returns true
+*/
+func (errs List) Unwrap() []error {
+	return errs.errors.Copy().ToArray()
+}
+
+/*
+Traverse applies a function returning a Try to each element of the list and collects the successful results in a List.
+Unlike list.TraverseTry, it applies the function to every element and fails with a List of all the errors if there are any.
+Examples:
+Traverse(list.Of("1", "2"), func(s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) }) returns Success(List[int]([1,2]))
+Traverse(list.Of("x", "2", "y"), func(s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) }) returns Fail(List([errX, errY]))
+*/
+func Traverse[T any, R any](l list.List[T], f func(T) try.Try[R]) try.Try[list.List[R]] {
+	return Sequence(list.Map(l, f))
+}
+
+/*
+Sequence turns a List of Trys into a Try of List, failing with a List of all the errors if there are any.
+Examples:
+Sequence(list.Of(try.Success(1), try.Success(2))) returns Success(List[int]([1,2]))
+Sequence(list.Of(try.Fail[int](err1), try.Success(2), try.Fail[int](err2))) returns Fail(List([err1, err2]))
+*/
+func Sequence[T any](l list.List[try.Try[T]]) try.Try[list.List[T]] {
+	values := []T{}
+	var errs []error
+	list.ForEachIndexed(l, func(_ int, t try.Try[T]) {
+		try.BiForEach(t, func(err error) {
+			errs = append(errs, err)
+		}, func(value T) {
+			values = append(values, value)
+		})
+	})
+	if err := Of(errs...); err != nil {
+		return try.Fail[list.List[T]](err)
+	}
+	return try.Success(list.Pure(values))
+}
+
+/*
+ParTraverse is like Traverse, but applies the function using several goroutines as list.ParMap does.
+If the context of the options is cancelled before all elements are processed, it fails with the context error.
+Example:
+ParTraverse(list.Of("x", "2", "y"), parse, list.ParOptions{Parallelism: 2}) returns Fail(List([errX, errY]))
+*/
+func ParTraverse[T any, R any](l list.List[T], f func(T) try.Try[R], opts list.ParOptions) try.Try[list.List[R]] {
+	return try.FlatMap(list.ParMap(l, f, opts), Sequence[R])
+}
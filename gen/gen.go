@@ -0,0 +1,262 @@
+/*
+Package gen builds values of the go-structs containers from the raw bytes of a fuzzing input,
+so that structs holding Options, Eithers or Lists can take part in native Go fuzzing or go-fuzz.
+Every decision of a generator, such as whether an Option is present or how long a List is, consumes bytes of the input:
+the fuzzing engine mutating the bytes explores the values of the containers.
+
+Example:
+
+	func FuzzUser(f *testing.F) {
+		users := gen.Map2(gen.String(32), gen.OptionOf(gen.Int()), func(name string, age option.Option[int]) User {
+			return User{Name: name, Age: age}
+		})
+		f.Fuzz(func(t *testing.T, data []byte) {
+			user := gen.Generate(data, users)
+			...
+		})
+	}
+*/
+package gen
+
+import (
+	"encoding/binary"
+	"github.com/Sugther/go-structs/either"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/set"
+	"math"
+)
+
+/*
+Source supplies the decisions of generators from the bytes of a fuzzing input, consuming them from the start.
+Once the bytes are exhausted it supplies zeros, so any input, including an empty one, generates a value.
+*/
+type Source struct {
+	data []byte
+}
+
+/*
+NewSource creates a new Source reading the given bytes.
+Example: NewSource([]byte{1, 2, 3}).Byte() returns 1
+*/
+func NewSource(data []byte) *Source {
+	return &Source{data: data}
+}
+
+/*
+Byte consumes and returns the next byte of the input, or 0 if the input is exhausted.
+Example: NewSource([]byte{7}).Byte() returns 7
+*/
+func (source *Source) Byte() byte {
+	if len(source.data) == 0 {
+		return 0
+	}
+	b := source.data[0]
+	source.data = source.data[1:]
+	return b
+}
+
+/*
+Uint64 consumes up to 8 bytes of the input and returns them as a little-endian uint64.
+Example: NewSource([]byte{1, 1}).Uint64() returns 257
+*/
+func (source *Source) Uint64() uint64 {
+	var buffer [8]byte
+	n := copy(buffer[:], source.data)
+	source.data = source.data[n:]
+	return binary.LittleEndian.Uint64(buffer[:])
+}
+
+/*
+Bool consumes a byte of the input and returns true if it is odd.
+Example: NewSource([]byte{1}).Bool() returns true
+*/
+func (source *Source) Bool() bool {
+	return source.Byte()&1 == 1
+}
+
+/*
+Intn consumes a byte of the input, or 8 bytes if n is greater than 256, and returns a value in [0, n).
+It returns 0 if n is not positive.
+Example: NewSource([]byte{7}).Intn(5) returns 2
+*/
+func (source *Source) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n <= 256 {
+		return int(source.Byte()) % n
+	}
+	return int(source.Uint64() % uint64(n))
+}
+
+/*
+Gen is a generator of values of type T drawing its decisions from a Source.
+*/
+type Gen[T any] func(source *Source) T
+
+/*
+Generate returns the value generated by g from the given fuzzing input.
+Example: Generate([]byte{1, 42}, OptionOf(Byte())) returns Option[byte](42)
+*/
+func Generate[T any](data []byte, g Gen[T]) T {
+	return g(NewSource(data))
+}
+
+/*
+Const returns a generator always returning the given value, without consuming the input.
+Example: Generate(nil, Const(42)) returns 42
+*/
+func Const[T any](value T) Gen[T] {
+	return func(*Source) T { return value }
+}
+
+/*
+Bool returns a generator of booleans consuming one byte.
+Example: Generate([]byte{1}, Bool()) returns true
+*/
+func Bool() Gen[bool] {
+	return (*Source).Bool
+}
+
+/*
+Byte returns a generator of bytes consuming one byte.
+Example: Generate([]byte{42}, Byte()) returns 42
+*/
+func Byte() Gen[byte] {
+	return (*Source).Byte
+}
+
+/*
+Int returns a generator of ints covering the whole int range, consuming 8 bytes.
+Example: Generate([]byte{42}, Int()) returns 42
+*/
+func Int() Gen[int] {
+	return func(source *Source) int { return int(source.Uint64()) }
+}
+
+/*
+IntRange returns a generator of ints between min and max inclusive. It panics if max is less than min.
+Example: Generate([]byte{7}, IntRange(10, 14)) returns 12
+*/
+func IntRange(min int, max int) Gen[int] {
+	if max < min {
+		panic("gen: IntRange with max less than min")
+	}
+	span := uint64(max) - uint64(min) + 1
+	return func(source *Source) int {
+		if span == 0 {
+			return int(source.Uint64())
+		}
+		if span <= 256 {
+			return min + int(uint64(source.Byte())%span)
+		}
+		return min + int(source.Uint64()%span)
+	}
+}
+
+/*
+Float64 returns a generator of float64 values, including infinities and NaN, consuming 8 bytes.
+Example: Generate([]byte{0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, Float64()) returns 1.0
+*/
+func Float64() Gen[float64] {
+	return func(source *Source) float64 { return math.Float64frombits(source.Uint64()) }
+}
+
+/*
+String returns a generator of strings of at most maxLen bytes, which may not be valid UTF-8.
+Example: Generate([]byte{2, 'h', 'i'}, String(10)) returns "hi"
+*/
+func String(maxLen int) Gen[string] {
+	return func(source *Source) string {
+		n := source.Intn(maxLen + 1)
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = source.Byte()
+		}
+		return string(b)
+	}
+}
+
+/*
+Map returns a generator applying f to the values generated by g.
+Example: Generate([]byte{2}, Map(Byte(), func(b byte) int { return int(b) * 2 })) returns 4
+*/
+func Map[T any, R any](g Gen[T], f func(T) R) Gen[R] {
+	return func(source *Source) R { return f(g(source)) }
+}
+
+/*
+Map2 returns a generator applying f to the values generated by g1 then g2, typically to build a struct.
+Example: Generate([]byte{1, 2}, Map2(Byte(), Byte(), func(a byte, b byte) int { return int(a + b) })) returns 3
+*/
+func Map2[T1 any, T2 any, R any](g1 Gen[T1], g2 Gen[T2], f func(T1, T2) R) Gen[R] {
+	return func(source *Source) R {
+		v1 := g1(source)
+		return f(v1, g2(source))
+	}
+}
+
+/*
+OneOf returns a generator choosing one of the given generators with one byte, then generating a value with it.
+It panics if no generator is given.
+Example: Generate([]byte{1}, OneOf(Const(1), Const(2))) returns 2
+*/
+func OneOf[T any](gens ...Gen[T]) Gen[T] {
+	if len(gens) == 0 {
+		panic("gen: OneOf without generators")
+	}
+	return func(source *Source) T {
+		return gens[source.Intn(len(gens))](source)
+	}
+}
+
+/*
+OptionOf returns a generator of Options: one byte decides whether the Option is present, then g generates its value.
+Examples:
+Generate([]byte{1, 42}, OptionOf(Byte())) returns Option[byte](42)
+Generate([]byte{0}, OptionOf(Byte())) returns Option[byte]{isPresent: false}
+*/
+func OptionOf[T any](g Gen[T]) Gen[option.Option[T]] {
+	return func(source *Source) option.Option[T] {
+		if !source.Bool() {
+			return option.Empty[T]()
+		}
+		return option.Pure(g(source))
+	}
+}
+
+/*
+EitherOf returns a generator of Eithers: one byte decides whether the Either is a Right, generated by genR,
+or a Left, generated by genL.
+Examples:
+Generate([]byte{1, 42}, EitherOf(String(4), Byte())) returns Right[string, byte](42)
+Generate([]byte{0, 1, 'e'}, EitherOf(String(4), Byte())) returns Left[string, byte]("e")
+*/
+func EitherOf[L any, R any](genL Gen[L], genR Gen[R]) Gen[either.Either[L, R]] {
+	return func(source *Source) either.Either[L, R] {
+		if source.Bool() {
+			return either.Right[L](genR(source))
+		}
+		return either.Left[L, R](genL(source))
+	}
+}
+
+/*
+ListOf returns a generator of Lists of at most maxLen elements generated by g. The length is decided first, with Source.Intn.
+Example: Generate([]byte{2, 4, 5}, ListOf(Byte(), 3)) returns List[byte]([4,5])
+*/
+func ListOf[T any](g Gen[T], maxLen int) Gen[list.List[T]] {
+	return func(source *Source) list.List[T] {
+		return list.Tabulate(source.Intn(maxLen+1), func(int) T { return g(source) })
+	}
+}
+
+/*
+SetOf returns a generator of Sets of at most maxLen elements generated by g, drawn like ListOf;
+duplicates are removed, so the Set may be smaller.
+Example: Generate([]byte{3, 4, 5, 4}, SetOf(Byte(), 3)) returns Set[byte]([4,5])
+*/
+func SetOf[T any](g Gen[T], maxLen int) Gen[set.Set[T]] {
+	return Map(ListOf(g, maxLen), set.Distinct[T])
+}
@@ -0,0 +1,206 @@
+package hash
+
+import (
+	"github.com/Sugther/go-structs/equal"
+	"hash/maphash"
+	"math"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+/*
+Hashable is an interface that defines a single method `HashCode`, which returns a `uint64`.
+It is the hashing counterpart of equal.Equal: values that are equal according to their Equals method
+must have the same HashCode, so that they can be grouped in hash tables before being compared.
+*/
+type Hashable interface {
+	HashCode() uint64
+}
+
+// maxDepth bounds the nesting of the values Of walks through, so that cyclic values do not loop forever.
+const maxDepth = 32
+
+var (
+	seed        = maphash.MakeSeed()
+	equalType   = reflect.TypeOf((*equal.Equal)(nil)).Elem()
+	hashType    = reflect.TypeOf((*Hashable)(nil)).Elem()
+	registryMux sync.RWMutex
+	registry    = map[reflect.Type]func(interface{}) uint64{}
+)
+
+/*
+Of returns a hash code of the given value consistent with equal.Equals: values equal according to equal.Equals
+have the same hash code. The hash codes are only stable within a process.
+If the value implements Hashable, its HashCode method is used. Otherwise, the function registered with Register
+for its type is used if there is one. Other values implementing equal.Equal all get the same hash code, since nothing is
+known of their equality. Booleans, numbers and strings are hashed from their value, and slices, arrays, maps, structs
+and pointers are hashed from their elements, fields or pointed value.
+Examples:
+Of("go") == Of("go") returns true
+Of(list.Of(1, 2)) == Of(list.Of(1, 2)) returns true
+*/
+func Of(value interface{}) uint64 {
+	if value == nil {
+		return 0
+	}
+	v := reflect.New(reflect.TypeOf(value)).Elem()
+	v.Set(reflect.ValueOf(value))
+	return hashValue(v, 0)
+}
+
+/*
+Combine returns a hash code made of the given hash codes, in order.
+It is meant to implement Hashable for types made of several values.
+Example: Combine(Of(1), Of("a")) returns the hash code of the pair (1, "a")
+*/
+func Combine(hashes ...uint64) uint64 {
+	result := uint64(len(hashes))
+	for _, h := range hashes {
+		result = mix(result ^ h + 0x9e3779b97f4a7c15)
+	}
+	return result
+}
+
+/*
+Register sets the function used by Of to hash values of type T, replacing any previously registered one.
+It must be consistent with the equality registered with equal.Register for the same type, if any.
+Functions are registered by default for *big.Int, *big.Float, *big.Rat and time.Time, consistent with the default
+equalities of the equal package.
+Example: Register(func(d decimal.Decimal) uint64 { return Of(d.String()) })
+*/
+func Register[T any](f func(T) uint64) {
+	registryMux.Lock()
+	defer registryMux.Unlock()
+	registry[reflect.TypeOf((*T)(nil)).Elem()] = func(value interface{}) uint64 {
+		return f(value.(T))
+	}
+}
+
+func registered(t reflect.Type) (func(interface{}) uint64, bool) {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+	f, ok := registry[t]
+	return f, ok
+}
+
+func init() {
+	Register(func(n *big.Int) uint64 {
+		if n == nil {
+			return 0
+		}
+		return Combine(uint64(n.Sign()+1), maphash.Bytes(seed, n.Bytes()))
+	})
+	Register(func(f *big.Float) uint64 {
+		if f == nil {
+			return 0
+		}
+		value, _ := f.Float64()
+		return hashFloat(value)
+	})
+	Register(func(r *big.Rat) uint64 {
+		if r == nil {
+			return 0
+		}
+		return Combine(Of(r.Num()), Of(r.Denom()))
+	})
+	Register(func(t time.Time) uint64 {
+		return Combine(uint64(t.Unix()), uint64(t.Nanosecond()))
+	})
+}
+
+func hashValue(v reflect.Value, depth int) uint64 {
+	if depth > maxDepth {
+		return 0
+	}
+	if !v.CanInterface() && v.CanAddr() {
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	if v.CanInterface() && v.Kind() != reflect.Interface {
+		if h, ok := v.Interface().(Hashable); ok {
+			if v.Kind() == reflect.Pointer && v.IsNil() {
+				return 0
+			}
+			return h.HashCode()
+		}
+		if f, ok := registered(v.Type()); ok {
+			return f(v.Interface())
+		}
+	}
+	if v.Type().Implements(hashType) || v.Type().Implements(equalType) {
+		return 1
+	}
+	if _, ok := registered(v.Type()); ok {
+		return 1
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return mix(1)
+		}
+		return mix(0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return Combine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.String:
+		return maphash.String(seed, v.String())
+	case reflect.Chan, reflect.UnsafePointer:
+		return mix(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return hashValue(v.Elem(), depth+1)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return 0
+		}
+		return hashValue(v.Elem(), depth+1)
+	case reflect.Slice, reflect.Array:
+		hashes := make([]uint64, v.Len())
+		for i := range hashes {
+			hashes[i] = hashValue(v.Index(i), depth+1)
+		}
+		return Combine(hashes...)
+	case reflect.Map:
+		var result uint64
+		entries := v.MapRange()
+		for entries.Next() {
+			result += Combine(hashValue(entries.Key(), depth+1), hashValue(entries.Value(), depth+1))
+		}
+		return mix(result + uint64(v.Len()))
+	case reflect.Struct:
+		hashes := make([]uint64, v.NumField())
+		for i := range hashes {
+			hashes[i] = hashValue(v.Field(i), depth+1)
+		}
+		return Combine(hashes...)
+	}
+	return 0
+}
+
+// hashFloat hashes a float so that 0 and -0, which are equal, have the same hash code.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return mix(0)
+	}
+	return mix(math.Float64bits(f))
+}
+
+// mix scrambles the bits of h, using the finalizer of splitmix64.
+func mix(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
@@ -0,0 +1,310 @@
+package hashset
+
+import (
+	"fmt"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/set"
+	"strings"
+)
+
+/*
+HashSet is a generic struct representing a set of unique comparable values of type T, backed by a map.
+Contains runs in O(1) and building a HashSet of n elements in O(n), where set.Set needs linear scans.
+Like the other containers, a HashSet is immutable: operations returning a HashSet copy it first.
+The iteration order of its elements is unspecified.
+*/
+type HashSet[T comparable] struct {
+	values map[T]struct{}
+}
+
+/*
+Pure creates a new HashSet containing the given values.
+Example: Pure([]int{1, 2, 3, 3}) returns HashSet[int]([1,2,3])
+*/
+func Pure[T comparable](values []T) HashSet[T] {
+	result := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		result[v] = struct{}{}
+	}
+	return HashSet[T]{values: result}
+}
+
+/*
+Of creates a new HashSet containing the given values.
+Example: Of(1, 2, 3, 3) returns HashSet[int]([1,2,3])
+*/
+func Of[T comparable](values ...T) HashSet[T] {
+	return Pure(values)
+}
+
+/*
+Empty creates a new empty HashSet.
+Example: Empty[int]() returns HashSet[int]([])
+*/
+func Empty[T comparable]() HashSet[T] {
+	return Pure([]T{})
+}
+
+/*
+FromList creates a new HashSet containing the elements of the given list.
+Example: FromList(list.Of(1, 2, 2)) returns HashSet[int]([1,2])
+*/
+func FromList[T comparable](l list.List[T]) HashSet[T] {
+	return Pure(l.ToArray())
+}
+
+/*
+FromSet creates a new HashSet containing the elements of the given set.
+Example: FromSet(set.Of(1, 2)) returns HashSet[int]([1,2])
+*/
+func FromSet[T comparable](s set.Set[T]) HashSet[T] {
+	return Pure(s.ToArray())
+}
+
+/*
+Len returns the number of elements of the given set.
+Example: Len(Of(1, 2, 3)) returns 3
+*/
+func Len[T comparable](set HashSet[T]) int {
+	return len(set.values)
+}
+
+func (set HashSet[T]) Len() int {
+	return Len(set)
+}
+
+/*
+IsEmpty returns true if the given set is empty, false otherwise.
+Examples:
+IsEmpty(Of(1, 2, 3)) returns false
+IsEmpty(Empty[int]()) returns true
+*/
+func IsEmpty[T comparable](set HashSet[T]) bool {
+	return Len(set) == 0
+}
+
+func (set HashSet[T]) IsEmpty() bool {
+	return IsEmpty(set)
+}
+
+/*
+NonEmpty returns true if the given set is not empty, false otherwise.
+Examples:
+NonEmpty(Of(1, 2, 3)) returns true
+NonEmpty(Empty[int]()) returns false
+*/
+func NonEmpty[T comparable](set HashSet[T]) bool {
+	return !IsEmpty(set)
+}
+
+func (set HashSet[T]) NonEmpty() bool {
+	return NonEmpty(set)
+}
+
+/*
+Contains returns true if the given value is present in the set, in O(1).
+Examples:
+Contains(Of(1, 2, 3), 3) returns true
+Contains(Of(1, 2, 3), 4) returns false
+*/
+func Contains[T comparable](set HashSet[T], value T) bool {
+	_, found := set.values[value]
+	return found
+}
+
+func (set HashSet[T]) Contains(value T) bool {
+	return Contains(set, value)
+}
+
+/*
+Append returns a new HashSet with the given values added to the original set.
+Example: Append(Of(1, 2, 3), 3, 4) returns HashSet[int]([1,2,3,4])
+*/
+func Append[T comparable](set HashSet[T], values ...T) HashSet[T] {
+	result := copyValues(set, len(values))
+	for _, v := range values {
+		result[v] = struct{}{}
+	}
+	return HashSet[T]{values: result}
+}
+
+func (set HashSet[T]) Append(values ...T) HashSet[T] {
+	return Append(set, values...)
+}
+
+/*
+Remove returns a new HashSet without the given values.
+Example: Remove(Of(1, 2, 3), 2, 4) returns HashSet[int]([1,3])
+*/
+func Remove[T comparable](set HashSet[T], values ...T) HashSet[T] {
+	result := copyValues(set, 0)
+	for _, v := range values {
+		delete(result, v)
+	}
+	return HashSet[T]{values: result}
+}
+
+func (set HashSet[T]) Remove(values ...T) HashSet[T] {
+	return Remove(set, values...)
+}
+
+/*
+Union returns a new HashSet containing the elements of both sets.
+Example: Union(Of(1, 2), Of(2, 3)) returns HashSet[int]([1,2,3])
+*/
+func Union[T comparable](set1 HashSet[T], set2 HashSet[T]) HashSet[T] {
+	result := copyValues(set1, Len(set2))
+	for v := range set2.values {
+		result[v] = struct{}{}
+	}
+	return HashSet[T]{values: result}
+}
+
+func (set HashSet[T]) Union(set2 HashSet[T]) HashSet[T] {
+	return Union(set, set2)
+}
+
+/*
+Intersection returns a new HashSet containing the elements present in both sets.
+Example: Intersection(Of(1, 2, 3), Of(2, 3, 4)) returns HashSet[int]([2,3])
+*/
+func Intersection[T comparable](set1 HashSet[T], set2 HashSet[T]) HashSet[T] {
+	if Len(set2) < Len(set1) {
+		set1, set2 = set2, set1
+	}
+	return Filter(set1, set2.Contains)
+}
+
+func (set HashSet[T]) Intersection(set2 HashSet[T]) HashSet[T] {
+	return Intersection(set, set2)
+}
+
+/*
+Difference returns a new HashSet containing the elements of set1 that are not in set2.
+Example: Difference(Of(1, 2, 3), Of(2, 3, 4)) returns HashSet[int]([1])
+*/
+func Difference[T comparable](set1 HashSet[T], set2 HashSet[T]) HashSet[T] {
+	return Filter(set1, func(t T) bool { return !Contains(set2, t) })
+}
+
+func (set HashSet[T]) Difference(set2 HashSet[T]) HashSet[T] {
+	return Difference(set, set2)
+}
+
+/*
+Filter returns a new HashSet containing only the elements that satisfy the given predicate function.
+Example: Filter(Of(1, 2, 3, 4), func(n int) bool { return n % 2 == 0 }) returns HashSet[int]([2,4])
+*/
+func Filter[T comparable](set HashSet[T], f func(T) bool) HashSet[T] {
+	result := map[T]struct{}{}
+	for v := range set.values {
+		if f(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return HashSet[T]{values: result}
+}
+
+func (set HashSet[T]) Filter(f func(T) bool) HashSet[T] {
+	return Filter(set, f)
+}
+
+/*
+Map applies a function to each element of the set and returns a new HashSet with the results.
+Example: Map(Of(1, 2, 3), func(n int) int { return n % 2 }) returns HashSet[int]([0,1])
+*/
+func Map[T comparable, R comparable](set HashSet[T], f func(T) R) HashSet[R] {
+	result := make(map[R]struct{}, Len(set))
+	for v := range set.values {
+		result[f(v)] = struct{}{}
+	}
+	return HashSet[R]{values: result}
+}
+
+/*
+Fold applies a function to the elements of the set in a cumulative way, starting from the given root value.
+The elements are visited in an unspecified order.
+Example: Fold(Of(1, 2, 3), 0, func(a int, b int) int { return a + b }) returns 6
+*/
+func Fold[T comparable, R any](set HashSet[T], root R, f func(R, T) R) R {
+	result := root
+	for v := range set.values {
+		result = f(result, v)
+	}
+	return result
+}
+
+/*
+ToArray returns the elements of the set as a new slice, in an unspecified order.
+Example: ToArray(Of(1, 2, 3)) returns []int{1, 2, 3} in any order
+*/
+func ToArray[T comparable](set HashSet[T]) []T {
+	return Fold(set, make([]T, 0, Len(set)), func(values []T, v T) []T {
+		return append(values, v)
+	})
+}
+
+func (set HashSet[T]) ToArray() []T {
+	return ToArray(set)
+}
+
+/*
+ToList returns the elements of the set as a list.List, in an unspecified order.
+Example: ToList(Of(1, 2, 3)) returns List[int]([1,2,3]) in any order
+*/
+func ToList[T comparable](set HashSet[T]) list.List[T] {
+	return list.Pure(ToArray(set))
+}
+
+func (set HashSet[T]) ToList() list.List[T] {
+	return ToList(set)
+}
+
+/*
+ToSet returns the elements of the set as a set.Set.
+Example: ToSet(Of(1, 2, 3)) returns Set[int]([1,2,3]) in any order
+*/
+func ToSet[T comparable](s HashSet[T]) set.Set[T] {
+	return set.Pure(ToArray(s))
+}
+
+func (set HashSet[T]) ToSet() set.Set[T] {
+	return ToSet(set)
+}
+
+/*
+Equals returns true if other is a HashSet with the same elements.
+Example: Of(1, 2, 3).Equals(Of(3, 2, 1)) returns true.
+*/
+func (set HashSet[T]) Equals(other interface{}) bool {
+	os, ok := other.(HashSet[T])
+	if !ok || Len(set) != Len(os) {
+		return false
+	}
+	for v := range set.values {
+		if !Contains(os, v) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+String returns a readable representation of the set, implementing fmt.Stringer.
+Example: Of(1, 2, 3).String() returns "HashSet(1, 2, 3)" in any order
+*/
+func (set HashSet[T]) String() string {
+	values := make([]string, 0, Len(set))
+	for v := range set.values {
+		values = append(values, fmt.Sprint(v))
+	}
+	return "HashSet(" + strings.Join(values, ", ") + ")"
+}
+
+func copyValues[T comparable](set HashSet[T], extra int) map[T]struct{} {
+	result := make(map[T]struct{}, Len(set)+extra)
+	for v := range set.values {
+		result[v] = struct{}{}
+	}
+	return result
+}
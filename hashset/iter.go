@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package hashset
+
+import "iter"
+
+/*
+Iter returns an iterator over the elements of the set, in an unspecified order that may change between iterations.
+Example: for v := range Iter(Of(1, 2, 3)) { fmt.Println(v) } prints 1, 2 and 3 in any order
+*/
+func Iter[T comparable](set HashSet[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range set.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (set HashSet[T]) Iter() iter.Seq[T] {
+	return Iter(set)
+}
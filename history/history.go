@@ -0,0 +1,151 @@
+package history
+
+import (
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/persistent"
+)
+
+/*
+History is an immutable undoable value container holding a current version of type T,
+the versions it replaced and the versions undone since the last Push.
+Every operation returns a new History sharing its versions with the original one in persistent Lists,
+so keeping older Histories around is cheap and each operation runs in O(log n).
+*/
+type History[T any] struct {
+	past    persistent.List[T]
+	current T
+	future  persistent.List[T]
+}
+
+/*
+New creates a new History whose only version is the given initial value.
+Example: New("a") returns History[string](current: "a")
+*/
+func New[T any](initial T) History[T] {
+	return History[T]{
+		past:    persistent.Empty[T](),
+		current: initial,
+		future:  persistent.Empty[T](),
+	}
+}
+
+/*
+Current returns the current version of the History.
+Example: Current(New("a").Push("b")) returns "b"
+*/
+func Current[T any](history History[T]) T {
+	return history.current
+}
+
+func (history History[T]) Current() T {
+	return Current(history)
+}
+
+/*
+Push returns a new History whose current version is the given value, the previous one becoming undoable.
+The versions that could be redone are discarded.
+Example: Push(New("a"), "b") returns History[string](past: ["a"], current: "b")
+*/
+func Push[T any](history History[T], value T) History[T] {
+	return History[T]{
+		past:    history.past.Append(history.current),
+		current: value,
+		future:  persistent.Empty[T](),
+	}
+}
+
+func (history History[T]) Push(value T) History[T] {
+	return Push(history, value)
+}
+
+/*
+Undo returns a new History whose current version is the previous one wrapped in an Option,
+or an empty Option if there is nothing to undo.
+Examples:
+Undo(New("a").Push("b")) returns Option(History[string](current: "a", future: ["b"]))
+Undo(New("a")) returns Option[History[string]]{isPresent: false}
+*/
+func Undo[T any](history History[T]) option.Option[History[T]] {
+	last := history.past.Len() - 1
+	return option.Map(history.past.Get(last), func(previous T) History[T] {
+		return History[T]{
+			past:    history.past.Remove(last),
+			current: previous,
+			future:  history.future.Prepend(history.current),
+		}
+	})
+}
+
+func (history History[T]) Undo() option.Option[History[T]] {
+	return Undo(history)
+}
+
+/*
+Redo returns a new History whose current version is the last undone one wrapped in an Option,
+or an empty Option if there is nothing to redo.
+Examples:
+Redo(New("a").Push("b").Undo().Get()) returns Option(History[string](past: ["a"], current: "b"))
+Redo(New("a")) returns Option[History[string]]{isPresent: false}
+*/
+func Redo[T any](history History[T]) option.Option[History[T]] {
+	return option.Map(history.future.Get(0), func(next T) History[T] {
+		return History[T]{
+			past:    history.past.Append(history.current),
+			current: next,
+			future:  history.future.Remove(0),
+		}
+	})
+}
+
+func (history History[T]) Redo() option.Option[History[T]] {
+	return Redo(history)
+}
+
+/*
+CanUndo returns true if Undo would return a History.
+Example: CanUndo(New("a").Push("b")) returns true
+*/
+func CanUndo[T any](history History[T]) bool {
+	return !history.past.IsEmpty()
+}
+
+func (history History[T]) CanUndo() bool {
+	return CanUndo(history)
+}
+
+/*
+CanRedo returns true if Redo would return a History.
+Example: CanRedo(New("a").Push("b")) returns false
+*/
+func CanRedo[T any](history History[T]) bool {
+	return !history.future.IsEmpty()
+}
+
+func (history History[T]) CanRedo() bool {
+	return CanRedo(history)
+}
+
+/*
+Squash returns a new History keeping only the current version, as if it had been created with New.
+Example: Squash(New("a").Push("b")).CanUndo() returns false
+*/
+func Squash[T any](history History[T]) History[T] {
+	return New(history.current)
+}
+
+func (history History[T]) Squash() History[T] {
+	return Squash(history)
+}
+
+/*
+Versions returns all the versions up to the current one, oldest first. Undone versions are not included.
+Example: Versions(New("a").Push("b").Push("c")) returns List[string](["a","b","c"])
+*/
+func Versions[T any](history History[T]) list.List[T] {
+	return history.past.Append(history.current).ToList()
+}
+
+func (history History[T]) Versions() list.List[T] {
+	return Versions(history)
+}
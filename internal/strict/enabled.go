@@ -0,0 +1,8 @@
+//go:build gostructs_strict
+
+package strict
+
+/*
+Enabled reports whether the library was built with the `gostructs_strict` tag.
+*/
+const Enabled = true
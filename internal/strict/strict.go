@@ -0,0 +1,17 @@
+/*
+Package strict controls how unsafe accessors such as option.Get or list.Tail behave when they are misused.
+By default they are lenient and return zero values. Building with the `gostructs_strict` tag
+(go build -tags gostructs_strict) makes them panic immediately instead, so misuses surface with a stack trace
+during development rather than silently propagating zero values.
+*/
+package strict
+
+/*
+Check panics with the given message if strict mode is enabled and the condition does not hold.
+It does nothing in lenient mode.
+*/
+func Check(condition bool, message string) {
+	if Enabled && !condition {
+		panic(message)
+	}
+}
@@ -0,0 +1,177 @@
+package kvstore
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Sugther/go-structs/try"
+	"sync"
+)
+
+/*
+Store is the minimal interface of an embedded key-value store such as bbolt or badger.
+Get returns false when the key is absent. Implementations must not retain the slices they are given.
+*/
+type Store interface {
+	Get(key []byte) ([]byte, bool, error)
+	Put(key []byte, value []byte) error
+}
+
+/*
+Codec converts values of type T, typically a set.Set or a list.List, to and from bytes.
+*/
+type Codec[T any] interface {
+	Encode(value T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+/*
+ErrNotFound is the error wrapped by Load when the key is absent from the Store.
+*/
+var ErrNotFound = errors.New("kvstore: key not found")
+
+/*
+Save encodes the value with the codec and stores it under the given key, replacing any previous value.
+Example: Save(store, "permissions", set.Of("read", "write"), GobCodec[set.Set[string]]()) checkpoints the set
+*/
+func Save[T any](store Store, key string, value T, codec Codec[T]) error {
+	data, err := codec.Encode(value)
+	if err != nil {
+		return fmt.Errorf("kvstore: encode %q: %w", key, err)
+	}
+	if err := store.Put([]byte(key), data); err != nil {
+		return fmt.Errorf("kvstore: put %q: %w", key, err)
+	}
+	return nil
+}
+
+/*
+Load reads the value stored under the given key and decodes it with the codec.
+It fails with an error wrapping ErrNotFound if the key is absent.
+Example: Load(store, "permissions", GobCodec[set.Set[string]]()) returns Success(Set[string](["read","write"]))
+*/
+func Load[T any](store Store, key string, codec Codec[T]) try.Try[T] {
+	data, found, err := store.Get([]byte(key))
+	if err != nil {
+		return try.Fail[T](fmt.Errorf("kvstore: get %q: %w", key, err))
+	}
+	if !found {
+		return try.Fail[T](fmt.Errorf("%w: %q", ErrNotFound, key))
+	}
+	value, err := codec.Decode(data)
+	if err != nil {
+		return try.Fail[T](fmt.Errorf("kvstore: decode %q: %w", key, err))
+	}
+	return try.Success(value)
+}
+
+/*
+Snapshotter is implemented by mutable containers that can take an immutable snapshot of their contents,
+such as concurrent.List and concurrent.Set.
+*/
+type Snapshotter[T any] interface {
+	Load() T
+}
+
+/*
+Sync takes a snapshot of the source and saves it under the given key, replacing any previous value.
+The snapshot is taken atomically, so concurrent updates of the source are either entirely saved or not at all.
+Example: Sync(store, "sessions", sessions, GobCodec[set.Set[string]]()) checkpoints the *concurrent.Set[string] sessions
+*/
+func Sync[T any](store Store, key string, source Snapshotter[T], codec Codec[T]) error {
+	return Save(store, key, source.Load(), codec)
+}
+
+/*
+Restore loads the value stored under the given key and replaces the contents of the target with it.
+The target is left untouched if the value cannot be loaded, and the error wraps ErrNotFound if the key is absent.
+Example: Restore(store, "sessions", sessions, GobCodec[set.Set[string]]()) reloads the *concurrent.Set[string] sessions
+*/
+func Restore[T any](store Store, key string, target interface{ Store(T) }, codec Codec[T]) error {
+	var err error
+	Load(store, key, codec).BiForEach(func(e error) {
+		err = e
+	}, target.Store)
+	return err
+}
+
+type codec[T any] struct {
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
+}
+
+func (c codec[T]) Encode(value T) ([]byte, error) {
+	return c.encode(value)
+}
+
+func (c codec[T]) Decode(data []byte) (T, error) {
+	return c.decode(data)
+}
+
+/*
+CodecOf creates a Codec from an encoding and a decoding function.
+Example: CodecOf(json.Marshal, func(data []byte) (int, error) { ... }) returns a Codec[int]
+*/
+func CodecOf[T any](encode func(T) ([]byte, error), decode func([]byte) (T, error)) Codec[T] {
+	return codec[T]{encode: encode, decode: decode}
+}
+
+/*
+GobCodec returns a Codec using encoding/gob.
+Example: GobCodec[set.Set[int]]() returns a Codec[set.Set[int]]
+*/
+func GobCodec[T any]() Codec[T] {
+	return CodecOf(func(value T) ([]byte, error) {
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}, func(data []byte) (T, error) {
+		var value T
+		err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value)
+		return value, err
+	})
+}
+
+/*
+JSONCodec returns a Codec using encoding/json.
+Example: JSONCodec[set.Set[int]]() returns a Codec[set.Set[int]]
+*/
+func JSONCodec[T any]() Codec[T] {
+	return CodecOf(func(value T) ([]byte, error) {
+		return json.Marshal(value)
+	}, func(data []byte) (T, error) {
+		var value T
+		err := json.Unmarshal(data, &value)
+		return value, err
+	})
+}
+
+/*
+MemoryStore is a Store keeping its entries in memory, safe for concurrent use.
+It is useful in tests and as a reference implementation. The zero value is an empty MemoryStore ready to use.
+*/
+type MemoryStore struct {
+	mutex   sync.RWMutex
+	entries map[string][]byte
+}
+
+func (store *MemoryStore) Get(key []byte) ([]byte, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	value, found := store.entries[string(key)]
+	return bytes.Clone(value), found, nil
+}
+
+func (store *MemoryStore) Put(key []byte, value []byte) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.entries == nil {
+		store.entries = map[string][]byte{}
+	}
+	store.entries[string(key)] = bytes.Clone(value)
+	return nil
+}
@@ -0,0 +1,43 @@
+package kvstore
+
+import (
+	"errors"
+	"github.com/Sugther/go-structs/concurrent"
+	"github.com/Sugther/go-structs/set"
+	"testing"
+)
+
+func TestSyncRestore(t *testing.T) {
+	for name, codec := range map[string]Codec[set.Set[string]]{
+		"gob":  GobCodec[set.Set[string]](),
+		"json": JSONCodec[set.Set[string]](),
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := &MemoryStore{}
+			source := concurrent.NewSet(set.Of("a", "b"))
+			if err := Sync[set.Set[string]](store, "sessions", source, codec); err != nil {
+				t.Fatalf("Sync returned %v", err)
+			}
+			source.Store(set.Of("c"))
+
+			target := concurrent.NewSet(set.Of[string]())
+			if err := Restore[set.Set[string]](store, "sessions", target, codec); err != nil {
+				t.Fatalf("Restore returned %v", err)
+			}
+			if got := target.Load(); !got.Equals(set.Of("a", "b")) {
+				t.Errorf("Restore loaded %v, want the snapshot taken by Sync", got)
+			}
+		})
+	}
+}
+
+func TestRestoreMissingKey(t *testing.T) {
+	target := concurrent.NewSet(set.Of(1))
+	err := Restore[set.Set[int]](&MemoryStore{}, "missing", target, GobCodec[set.Set[int]]())
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Restore returned %v, want ErrNotFound", err)
+	}
+	if got := target.Load(); !got.Equals(set.Of(1)) {
+		t.Errorf("Restore replaced the target with %v after a failure", got)
+	}
+}
@@ -0,0 +1,132 @@
+package lazy
+
+import (
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/try"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Once is a lazily initialized value of type T.
+The initialization function runs at most once, on first access, even when the Once is used from several goroutines
+or copied: all copies share the same value. A Once must be created with New or NewErr.
+*/
+type Once[T any] struct {
+	state *state[T]
+}
+
+type state[T any] struct {
+	once        sync.Once
+	initialized atomic.Bool
+	init        func() (T, error)
+	value       T
+	err         error
+}
+
+/*
+New creates a Once whose value is computed by f on first access.
+Example: New(func() int { return 42 }).Get() returns 42.
+*/
+func New[T any](f func() T) Once[T] {
+	return NewErr(func() (T, error) {
+		return f(), nil
+	})
+}
+
+/*
+NewErr creates a Once whose value is computed by f on first access.
+If f returns an error, the error is memoized as well and f is never called again.
+Example: NewErr(func() (int, error) { return strconv.Atoi("42") }).Try() returns Success[int](42).
+*/
+func NewErr[T any](f func() (T, error)) Once[T] {
+	return Once[T]{
+		state: &state[T]{
+			init: f,
+		},
+	}
+}
+
+func (once Once[T]) force() *state[T] {
+	s := once.state
+	s.once.Do(func() {
+		s.value, s.err = s.init()
+		s.init = nil
+		s.initialized.Store(true)
+	})
+	return s
+}
+
+/*
+Get returns the value, computing it if needed.
+If the initialization failed, it returns the value returned alongside the error, usually the zero value of T.
+Example: Get(New(func() int { return 42 })) returns 42.
+*/
+func Get[T any](once Once[T]) T {
+	return once.force().value
+}
+
+func (once Once[T]) Get() T {
+	return Get(once)
+}
+
+/*
+Try returns the value, computing it if needed, as a successful Try, or a failed Try holding the initialization error.
+Examples:
+Try(NewErr(func() (int, error) { return strconv.Atoi("42") })) returns Success[int](42)
+Try(NewErr(func() (int, error) { return strconv.Atoi("x") })) returns Fail[int](error)
+*/
+func Try[T any](once Once[T]) try.Try[T] {
+	s := once.force()
+	return try.Pure(s.value, s.err)
+}
+
+func (once Once[T]) Try() try.Try[T] {
+	return Try(once)
+}
+
+/*
+Option returns the value, computing it if needed, wrapped in an Option which is empty if the initialization failed.
+Examples:
+Option(New(func() int { return 42 })) returns Option[int](42)
+Option(NewErr(func() (int, error) { return strconv.Atoi("x") })) returns Option[int]{isPresent: false}
+*/
+func Option[T any](once Once[T]) option.Option[T] {
+	return Try(once).ToOption()
+}
+
+func (once Once[T]) Option() option.Option[T] {
+	return Option(once)
+}
+
+/*
+IsInitialized returns true if the value has already been computed, successfully or not.
+Examples:
+IsInitialized(New(func() int { return 42 })) returns false
+IsInitialized(o) returns true after o.Get() has been called.
+*/
+func IsInitialized[T any](once Once[T]) bool {
+	return once.state.initialized.Load()
+}
+
+func (once Once[T]) IsInitialized() bool {
+	return IsInitialized(once)
+}
+
+/*
+Peek returns the value wrapped in an Option without computing it.
+The Option is empty if the value has not been computed yet or if the initialization failed.
+Examples:
+Peek(New(func() int { return 42 })) returns Option[int]{isPresent: false}
+Peek(o) returns Option[int](42) after o.Get() has been called.
+*/
+func Peek[T any](once Once[T]) option.Option[T] {
+	if !IsInitialized(once) {
+		return option.Empty[T]()
+	}
+	return Option(once)
+}
+
+func (once Once[T]) Peek() option.Option[T] {
+	return Peek(once)
+}
@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package list
+
+import "iter"
+
+/*
+Iter returns an iterator over the elements of the list, in index order.
+Example: for v := range Iter(Of(1, 2, 3)) { fmt.Println(v) } prints 1, 2 and 3
+*/
+func Iter[T any](list List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range list.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (list List[T]) Iter() iter.Seq[T] {
+	return Iter(list)
+}
@@ -1,13 +1,38 @@
 package list
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"github.com/Sugther/go-structs/constraints"
+	"github.com/Sugther/go-structs/either"
 	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
+	"github.com/Sugther/go-structs/internal/strict"
+	"github.com/Sugther/go-structs/monoid"
 	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/try"
+	"github.com/Sugther/go-structs/tuple"
+	"math/rand"
+	"runtime"
 	"sort"
+	"strings"
+	"sync"
+	"unsafe"
 )
 
 /*
 List is a generic struct representing a list of values of type T.
+Lists are immutable: no function of this package modifies the elements visible through an existing List,
+and Append and AppendList always copy into a new backing array, so Lists derived from the same parent
+never observe each other's appended elements.
+Example:
+a := Of(1, 2, 3)
+b, c := a.Append(4), a.Append(5)
+b returns List[int]([1,2,3,4]) and c returns List[int]([1,2,3,5])
+The zero value is an empty List ready to use, so a List field left uninitialized in a struct behaves like Empty.
 */
 type List[T any] struct {
 	values []T
@@ -39,6 +64,90 @@ func Empty[T any]() List[T] {
 	return Pure([]T{})
 }
 
+/*
+Range creates a new List of the numbers from `from`, included, to `to`, excluded, separated by step.
+A negative step counts down, a zero step returns an empty List, and the range stops before the numbers overflow T.
+Each number is computed as from + i*step rather than by adding step repeatedly, so floating point errors do not accumulate.
+Examples:
+Range(0, 10, 3) returns List[int]([0,3,6,9])
+Range(5, 0, -2) returns List[int]([5,3,1])
+Range(0, 5, -1) returns List[int]([])
+Range(0.0, 1.0, 0.25) returns List[float64]([0,0.25,0.5,0.75])
+Range(0.0, 1.0, 0.1) returns 10 numbers, from 0 to 0.9
+*/
+func Range[T constraints.Integer | constraints.Float](from T, to T, step T) List[T] {
+	values := []T{}
+	for i, v := 1, from; (step > 0 && v < to) || (step < 0 && v > to); i++ {
+		values = append(values, v)
+		next := from + T(i)*step
+		if (step > 0 && next <= v) || (step < 0 && next >= v) {
+			break
+		}
+		v = next
+	}
+	return Pure(values)
+}
+
+/*
+Tabulate creates a new List of length n whose element at each index i is f(i).
+A negative length returns an empty List.
+Example: Tabulate(4, func(i int) int { return i * i }) returns List[int]([0,1,4,9])
+*/
+func Tabulate[T any](n int, f func(int) T) List[T] {
+	if n < 0 {
+		n = 0
+	}
+	values := make([]T, n)
+	for i := range values {
+		values[i] = f(i)
+	}
+	return Pure(values)
+}
+
+/*
+Fill creates a new List of length n whose elements are all the given value.
+A negative length returns an empty List.
+Example: Fill(3, "a") returns List[string](["a","a","a"])
+*/
+func Fill[T any](n int, value T) List[T] {
+	return Tabulate(n, func(int) T { return value })
+}
+
+/*
+FromMapKeys creates a new List containing the keys of the given map, in an unspecified order.
+Example: FromMapKeys(map[string]int{"a": 1, "b": 2}) returns List[string](["a","b"]) in any order
+*/
+func FromMapKeys[K comparable, V any](m map[K]V) List[K] {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return Pure(keys)
+}
+
+/*
+FromMapValues creates a new List containing the values of the given map, in an unspecified order.
+Example: FromMapValues(map[string]int{"a": 1, "b": 2}) returns List[int]([1,2]) in any order
+*/
+func FromMapValues[K comparable, V any](m map[K]V) List[V] {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return Pure(values)
+}
+
+/*
+FromOption creates a new List containing the value of the given Option if it is present, and an empty List otherwise.
+Combined with FlatMap, it keeps the present results of a function returning Options.
+Examples:
+FromOption(option.Pure(1)) returns List[int]([1])
+FlatMap(Of("1", "x"), func(s string) List[int] { return FromOption(parse(s)) }) returns List[int]([1])
+*/
+func FromOption[T any](opt option.Option[T]) List[T] {
+	return Pure(opt.ToSlice())
+}
+
 /*
 Len returns the length of the given list.
 Example: Len(Of(1, 2, 3)) returns 3
@@ -81,10 +190,14 @@ func (list List[T]) NonEmpty() bool {
 
 /*
 Append returns a new List with the given values appended to the original list.
+The result never shares its backing array with the original list, so it costs O(n);
+use a Builder to accumulate many elements.
 Example: Append(Of(1, 2, 3), 4, 5) returns List[int]([1,2,3,4,5])
 */
 func Append[T any](list List[T], values ...T) List[T] {
-	return Pure(append(list.values, values...))
+	results := make([]T, 0, len(list.values)+len(values))
+	results = append(results, list.values...)
+	return Pure(append(results, values...))
 }
 
 func (list List[T]) Append(values ...T) List[T] {
@@ -93,6 +206,7 @@ func (list List[T]) Append(values ...T) List[T] {
 
 /*
 AppendList returns a new List with the elements of list2 appended to the original list.
+Like Append, the result never shares its backing array with either input list.
 Example: AppendList(Of(1, 2, 3), Of(4, 5, 6)) returns List[int]([1,2,3,4,5,6])
 */
 func AppendList[T any](list List[T], list2 List[T]) List[T] {
@@ -108,7 +222,7 @@ Head returns the first element of the list wrapped in an Option.
 If the list is empty, it returns an empty Option.
 Examples:
 Head(Of(1, 2, 3)) returns Option[int](1)
-Head(Empty[int]()) returns Option[int]{isEmpty: true}
+Head(Empty[int]()) returns Option[int]{isPresent: false}
 */
 func Head[T any](list List[T]) option.Option[T] {
 	if IsEmpty(list) {
@@ -123,9 +237,16 @@ func (list List[T]) Head() option.Option[T] {
 
 /*
 Tail returns a new List containing all elements except the first one.
-Example: Tail(Of(1, 2, 3)) returns List[int]([2,3])
+The Tail of an empty list is an empty list, or a panic when built with the `gostructs_strict` tag.
+Examples:
+Tail(Of(1, 2, 3)) returns List[int]([2,3])
+Tail(Empty[int]()) returns List[int]([])
 */
 func Tail[T any](list List[T]) List[T] {
+	strict.Check(NonEmpty(list), "list: Tail called on an empty List")
+	if IsEmpty(list) {
+		return Empty[T]()
+	}
 	return Pure(list.values[1:])
 }
 
@@ -134,250 +255,1526 @@ func (list List[T]) Tail() List[T] {
 }
 
 /*
-Fold applies a function to the elements of the list in a cumulative way, starting from the given root value.
+Get returns the element at the given index wrapped in an Option.
+If the index is out of range, it returns an empty Option.
 Examples:
-Fold(Of(1, 2, 3, 4), 0, func(a int, b int) int { return a + b }) returns 10
-Fold(Empty[int](), 10, func(a int, b int) int { return a + b }) returns 10
+Get(Of(1, 2, 3), 1) returns Option[int](2)
+Get(Of(1, 2, 3), 3) returns Option[int]{isPresent: false}
 */
-func Fold[T any, R any](list List[T], root R, f func(R, T) R) R {
-	result := root
-	values := list.values
-	for i := 0; i < len(values)-1; i++ {
-		result = f(result, values[i])
+func Get[T any](list List[T], index int) option.Option[T] {
+	if index < 0 || index >= Len(list) {
+		return option.Empty[T]()
 	}
-	return result
+	return option.Pure(list.values[index])
+}
+
+func (list List[T]) Get(index int) option.Option[T] {
+	return Get(list, index)
 }
 
 /*
-FlatMap applies a function that returns a List for each element of the input list, then concatenates the resulting lists.
-Example:
-FlatMap(Of(1, 2, 3), func(n int) List[int] { return Of(n, n * 2) }) returns List[int]([1,2,2,4,3,6])
-FlatMap(Empty[int](), func(n int) List[int] { return Of(n, n * 2) }) returns List[int]([])
+Last returns the last element of the list wrapped in an Option.
+If the list is empty, it returns an empty Option.
+Examples:
+Last(Of(1, 2, 3)) returns Option[int](3)
+Last(Empty[int]()) returns Option[int]{isPresent: false}
 */
-func FlatMap[T any, R any](list List[T], f func(T) List[R]) List[R] {
-	return Fold(list, Empty[R](), func(l List[R], t T) List[R] {
-		return AppendList(l, f(t))
-	})
+func Last[T any](list List[T]) option.Option[T] {
+	return Get(list, Len(list)-1)
+}
+
+func (list List[T]) Last() option.Option[T] {
+	return Last(list)
 }
 
 /*
-Flatten returns a new List with all elements of the input List flattened into a single List.
-Example:
-Flatten(Of(Of(1, 2), Of(3, 4))) returns List[int]([1,2,3,4])
+Init returns a new List containing all elements except the last one.
+The Init of an empty list is an empty list.
+Examples:
+Init(Of(1, 2, 3)) returns List[int]([1,2])
+Init(Empty[int]()) returns List[int]([])
 */
-func Flatten[T any](list List[List[T]]) List[T] {
-	return FlatMap(list, func(t List[T]) List[T] { return t })
+func Init[T any](list List[T]) List[T] {
+	if IsEmpty(list) {
+		return Empty[T]()
+	}
+	last := Len(list) - 1
+	return Pure(list.values[:last:last])
+}
+
+func (list List[T]) Init() List[T] {
+	return Init(list)
 }
 
 /*
-Map applies a function to each element of the list and returns a new List with the results.
+TakeLastWhile returns a new List containing the longest suffix of the list whose elements all satisfy the predicate.
 Examples:
-Map(Of(1, 2, 3), func(n int) int { return n * n }) returns List[int]([1,4,9])
-Map(Empty[int](), func(n int) int { return n * n }) returns List[int]([])
+TakeLastWhile(Of(1, 2, 0, 0), func(n int) bool { return n == 0 }) returns List[int]([0,0])
+TakeLastWhile(Of(1, 2), func(n int) bool { return n == 0 }) returns List[int]([])
 */
-func Map[T any, R any](list List[T], f func(T) R) List[R] {
-	return FlatMap(list, func(t T) List[R] { return Of(f(t)) })
+func TakeLastWhile[T any](list List[T], f func(T) bool) List[T] {
+	end := len(list.values)
+	return Pure(list.values[suffixStart(list, f):end:end])
+}
+
+func (list List[T]) TakeLastWhile(f func(T) bool) List[T] {
+	return TakeLastWhile(list, f)
 }
 
 /*
-Filter returns a new List containing only the elements that satisfy the given predicate function.
-Example: Filter(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns List[int]([2,4])
+DropLastWhile returns a new List without the longest suffix of the list whose elements all satisfy the predicate.
+Examples:
+DropLastWhile(Of(1, 2, 0, 0), func(n int) bool { return n == 0 }) returns List[int]([1,2])
+DropLastWhile(Of(0, 0), func(n int) bool { return n == 0 }) returns List[int]([])
 */
-func Filter[T any](list List[T], f func(T) bool) List[T] {
-	return Fold(list, Empty[T](), func(l List[T], t T) List[T] {
-		if f(t) {
-			return Append(l, t)
-		}
-		return l
-	})
+func DropLastWhile[T any](list List[T], f func(T) bool) List[T] {
+	start := suffixStart(list, f)
+	return Pure(list.values[:start:start])
 }
 
-func (list List[T]) Filter(f func(T) bool) List[T] {
-	return Filter(list, f)
+func (list List[T]) DropLastWhile(f func(T) bool) List[T] {
+	return DropLastWhile(list, f)
 }
 
 /*
-Find returns the first element that satisfies the given predicate function, wrapped in an Option.
-If no elements satisfy the predicate, it returns an empty Option.
+TrimWhile returns a new List without the longest prefix and the longest suffix of the list whose elements all satisfy the predicate.
 Examples:
-Find(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns Option[int](2)
-Find(myList, func(n int) bool { return n < 0 }) returns Option[int]()
+TrimWhile(Of(0, 1, 0, 2, 0, 0), func(n int) bool { return n == 0 }) returns List[int]([1,0,2])
+TrimWhile(Of(0, 0), func(n int) bool { return n == 0 }) returns List[int]([])
 */
-func Find[T any](list List[T], f func(T) bool) option.Option[T] {
-	for _, v := range list.values {
-		if f(v) {
-			return option.Pure(v)
-		}
+func TrimWhile[T any](list List[T], f func(T) bool) List[T] {
+	end := suffixStart(list, f)
+	start := 0
+	for start < end && f(list.values[start]) {
+		start++
 	}
-	return option.Empty[T]()
+	return Pure(list.values[start:end:end])
 }
 
-func (list List[T]) Find(f func(T) bool) option.Option[T] {
-	return Find(list, f)
+func (list List[T]) TrimWhile(f func(T) bool) List[T] {
+	return TrimWhile(list, f)
+}
+
+// suffixStart returns the index of the first element of the longest suffix of the list whose elements all satisfy f.
+func suffixStart[T any](list List[T], f func(T) bool) int {
+	start := len(list.values)
+	for start > 0 && f(list.values[start-1]) {
+		start--
+	}
+	return start
 }
 
 /*
-AnyMatch returns true if any element in the list satisfies the given predicate function, false otherwise.
+InsertAt returns a new List with the given value inserted at the given index, shifting the following elements.
+An index equal to the length of the list appends the value. If the index is out of range, the list is returned unchanged.
 Examples:
-AnyMatch(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns true
-AnyMatch(Of(1, 2, 3, 4, 5), func(n int) bool { return n < 0 }) returns false
+InsertAt(Of(1, 2, 3), 1, 9) returns List[int]([1,9,2,3])
+InsertAt(Of(1, 2, 3), 5, 9) returns List[int]([1,2,3])
 */
-func AnyMatch[T any](list List[T], f func(T) bool) bool {
-	return Find(list, f).IsPresent()
+func InsertAt[T any](list List[T], index int, value T) List[T] {
+	if index < 0 || index > Len(list) {
+		return list
+	}
+	values := make([]T, 0, Len(list)+1)
+	values = append(values, list.values[:index]...)
+	values = append(values, value)
+	values = append(values, list.values[index:]...)
+	return Pure(values)
 }
 
-func (list List[T]) AnyMatch(f func(T) bool) bool {
-	return AnyMatch(list, f)
+func (list List[T]) InsertAt(index int, value T) List[T] {
+	return InsertAt(list, index, value)
 }
 
 /*
-Forall returns true if all elements in the input List satisfy the given predicate function, false otherwise.
+UpdateAt returns a new List with the element at the given index replaced by the result of f applied to it.
+If the index is out of range, the list is returned unchanged.
 Examples:
-Forall(Of(1, 2, 3), func(n int) bool { return n > 0 }) returns true
-Forall(Of(1, 2, 3), func(n int) bool { return n > 1 }) returns false
+UpdateAt(Of(1, 2, 3), 1, func(n int) int { return n * 10 }) returns List[int]([1,20,3])
+UpdateAt(Of(1, 2, 3), 5, func(n int) int { return n * 10 }) returns List[int]([1,2,3])
 */
-func Forall[T any](list List[T], f func(T) bool) bool {
-	return !AnyMatch(list, func(t T) bool { return !f(t) })
+func UpdateAt[T any](list List[T], index int, f func(T) T) List[T] {
+	if index < 0 || index >= Len(list) {
+		return list
+	}
+	values := Copy(list).values
+	values[index] = f(values[index])
+	return Pure(values)
 }
 
-func (list List[T]) Forall(f func(T) bool) bool {
-	return Forall(list, f)
+func (list List[T]) UpdateAt(index int, f func(T) T) List[T] {
+	return UpdateAt(list, index, f)
 }
 
 /*
-Remove returns a new List with all elements of the input List that do not satisfy the given predicate function.
+RemoveAt returns a new List without the element at the given index.
+If the index is out of range, the list is returned unchanged.
 Examples:
-Remove(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns List[int]([1,3,5])
-Remove(Of(), func(n int) bool { return n % 2 == 0 }) returns List[int]([])
+RemoveAt(Of(1, 2, 3), 1) returns List[int]([1,3])
+RemoveAt(Of(1, 2, 3), 5) returns List[int]([1,2,3])
 */
-func Remove[T any](list List[T], f func(T) bool) List[T] {
-	return Filter(list, func(t T) bool { return !f(t) })
+func RemoveAt[T any](list List[T], index int) List[T] {
+	if index < 0 || index >= Len(list) {
+		return list
+	}
+	values := make([]T, 0, Len(list)-1)
+	values = append(values, list.values[:index]...)
+	values = append(values, list.values[index+1:]...)
+	return Pure(values)
 }
 
-func (list List[T]) Remove(f func(T) bool) List[T] {
-	return Remove(list, f)
+func (list List[T]) RemoveAt(index int) List[T] {
+	return RemoveAt(list, index)
 }
 
 /*
-Copy returns a new List with all elements of the input List copied.
+Fold applies a function to the elements of the list in a cumulative way, starting from the given root value.
+Examples:
+Fold(Of(1, 2, 3, 4), 0, func(a int, b int) int { return a + b }) returns 10
+Fold(Empty[int](), 10, func(a int, b int) int { return a + b }) returns 10
+*/
+func Fold[T any, R any](list List[T], root R, f func(R, T) R) R {
+	return foldWhile(iterate(list), root, func(r R, t T) (R, bool) {
+		return f(r, t), true
+	})
+}
+
+/*
+FoldChunked works like Fold but processes the list in consecutive chunks of chunkSize elements
+and calls onChunk with the accumulated value after each chunk, including the last one,
+for progress reporting or checkpointing of long-running folds. A chunkSize lower than 1 processes the list as a single chunk.
+onChunk is not called on an empty list.
 Example:
-Copy(Of(1, 2, 3)) returns List[int]([1,2,3])
+FoldChunked(Of(1, 2, 3, 4, 5), 2, 0, func(a int, b int) int { return a + b }, func(acc int) { fmt.Println(acc) }) prints 3, 10 and 15, then returns 15
 */
-func Copy[T any](list List[T]) List[T] {
-	copyValues := make([]T, len(list.values))
-	copy(copyValues, list.values)
-	return Pure(copyValues)
+func FoldChunked[T any, R any](list List[T], chunkSize int, root R, f func(R, T) R, onChunk func(R)) R {
+	if chunkSize < 1 {
+		chunkSize = len(list.values)
+	}
+	result := root
+	for start := 0; start < len(list.values); start += chunkSize {
+		end := start + chunkSize
+		if end > len(list.values) {
+			end = len(list.values)
+		}
+		result = Fold(Pure(list.values[start:end]), result, f)
+		onChunk(result)
+	}
+	return result
 }
 
-func (list List[T]) Copy() List[T] {
-	return Copy(list)
+/*
+seq is a push iterator: it calls yield with each element in order and stops as soon as yield returns false.
+It is the single traversal that Fold, Find, and the functions built on Fold such as Map, Filter, FlatMap and Distinct, rely on.
+Those preallocate the slice they fold into, so the shared traversal costs no reallocation.
+*/
+type seq[T any] func(yield func(T) bool)
+
+func iterate[T any](list List[T]) seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range list.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
 }
 
 /*
-Sort returns a new List with all elements of the input List sorted according to the given comparison function.
-Example:
-Sort(Of(3, 1, 4, 1, 5, 9), func(a int, b int) bool { return a < b }) returns List[int]([1,1,3,4,5,9])
+foldWhile folds the elements of the iterator until f returns false, the accumulated value being kept in that case.
 */
-func Sort[T any](list List[T], isInOrder func(T, T) bool) List[T] {
-	copyValues := make([]T, len(list.values))
-	copy(copyValues, list.values)
-	sort.Slice(copyValues, func(i, j int) bool {
-		return isInOrder(copyValues[i], copyValues[j])
+func foldWhile[T any, R any](s seq[T], root R, f func(R, T) (R, bool)) R {
+	result := root
+	s(func(t T) bool {
+		r, ok := f(result, t)
+		result = r
+		return ok
 	})
-	return Pure(copyValues)
+	return result
 }
 
-func (list List[T]) Sort(isInOrder func(T, T) bool) List[T] {
-	return Sort(list, isInOrder)
+/*
+FoldMap maps each element of the list with f and combines the results using the given Monoid, starting from its identity element.
+Examples:
+FoldMap(Of(1, 2, 3), monoid.Sum[int](), func(n int) int { return n * n }) returns 14
+FoldMap(Empty[int](), monoid.Sum[int](), func(n int) int { return n * n }) returns 0
+*/
+func FoldMap[T any, R any](list List[T], m monoid.Monoid[R], f func(T) R) R {
+	return foldMap(list.values, m, f)
+}
+
+func foldMap[T any, R any](values []T, m monoid.Monoid[R], f func(T) R) R {
+	result := m.Empty()
+	for _, v := range values {
+		result = m.Combine(result, f(v))
+	}
+	return result
 }
 
 /*
-ToArray returns a new slice with all elements of the input List.
+ParFoldMap behaves like FoldMap but splits the list into at most parallelism contiguous chunks folded in separate goroutines.
+The partial results are combined in order, so the result is the same as FoldMap as long as the Monoid is associative.
+If parallelism is lower than 2, the list is folded in the calling goroutine.
 Example:
-ToArray(Of(1, 2, 3)) returns []int{1, 2, 3}
+ParFoldMap(Of(1, 2, 3, 4), monoid.Sum[int](), func(n int) int { return n * n }, 2) returns 30
 */
-func ToArray[T any](list List[T]) []T {
-	return list.values
+func ParFoldMap[T any, R any](list List[T], m monoid.Monoid[R], f func(T) R, parallelism int) R {
+	values := list.values
+	if parallelism > len(values) {
+		parallelism = len(values)
+	}
+	if parallelism < 2 {
+		return foldMap(values, m, f)
+	}
+	results := make([]R, parallelism)
+	chunkSize := (len(values) + parallelism - 1) / parallelism
+	var wg sync.WaitGroup
+	for i := range results {
+		start, end := i*chunkSize, (i+1)*chunkSize
+		if start > len(values) {
+			start = len(values)
+		}
+		if end > len(values) {
+			end = len(values)
+		}
+		wg.Add(1)
+		go func(i int, chunk []T) {
+			defer wg.Done()
+			results[i] = foldMap(chunk, m, f)
+		}(i, values[start:end])
+	}
+	wg.Wait()
+	return foldMap(results, m, func(r R) R { return r })
 }
 
-func (list List[T]) ToArray() []T {
-	return ToArray(list)
+/*
+Reduce combines the elements of the list from left to right using the first element as the starting value.
+If the list is empty, it returns an empty Option.
+Examples:
+Reduce(Of(1, 2, 3), func(a int, b int) int { return a - b }) returns Option[int](-4)
+Reduce(Empty[int](), func(a int, b int) int { return a - b }) returns Option[int]{isPresent: false}
+*/
+func Reduce[T any](list List[T], f func(T, T) T) option.Option[T] {
+	if IsEmpty(list) {
+		return option.Empty[T]()
+	}
+	result := list.values[0]
+	for _, v := range list.values[1:] {
+		result = f(result, v)
+	}
+	return option.Pure(result)
+}
+
+func (list List[T]) Reduce(f func(T, T) T) option.Option[T] {
+	return Reduce(list, f)
 }
 
 /*
-Contains returns true if the given value is present in the input List, false otherwise.
-It uses the Equals method of the elements in the List to compare for equality.
-Example:
-Contains(Of[int](1, 2, 3), 3) returns true
-Contains(Of[int](1, 2, 3), 4) returns false
+ReduceRight combines the elements of the list from right to left using the last element as the starting value.
+If the list is empty, it returns an empty Option.
+Examples:
+ReduceRight(Of(1, 2, 3), func(a int, b int) int { return a - b }) returns Option[int](2)
+ReduceRight(Empty[int](), func(a int, b int) int { return a - b }) returns Option[int]{isPresent: false}
 */
-func Contains[T any](list List[T], value T) bool {
-	return AnyMatch(list, func(t T) bool {
-		return equal.Equals(t, value)
-	})
+func ReduceRight[T any](list List[T], f func(T, T) T) option.Option[T] {
+	if IsEmpty(list) {
+		return option.Empty[T]()
+	}
+	last := len(list.values) - 1
+	result := list.values[last]
+	for i := last - 1; i >= 0; i-- {
+		result = f(list.values[i], result)
+	}
+	return option.Pure(result)
+}
+
+func (list List[T]) ReduceRight(f func(T, T) T) option.Option[T] {
+	return ReduceRight(list, f)
 }
 
 /*
-Distinct returns a new List with all duplicate elements removed from the input List.
-It uses the Equals method of the elements in the List to compare for equality.
-Example:
-Distinct(Of(1, 2, 3, 3)) returns List[int]([1, 2, 3])
+MinBy returns the smallest element of the list according to the given less function, wrapped in an Option.
+If several elements are equally small, the first one is returned. If the list is empty, it returns an empty Option.
+Examples:
+MinBy(Of("bb", "a", "ccc"), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]("a")
+MinBy(Empty[string](), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]{isPresent: false}
 */
-func Distinct[T any](list List[T]) List[T] {
-	return Fold(list, Empty[T](), func(uniqueList List[T], value T) List[T] {
-		if Contains(uniqueList, value) {
-			return uniqueList
+func MinBy[T any](list List[T], less func(T, T) bool) option.Option[T] {
+	return Reduce(list, func(min T, t T) T {
+		if less(t, min) {
+			return t
 		}
-		return Append(uniqueList, value)
+		return min
 	})
 }
 
-func (list List[T]) Distinct() List[T] {
-	return Distinct(list)
+func (list List[T]) MinBy(less func(T, T) bool) option.Option[T] {
+	return MinBy(list, less)
 }
 
 /*
-Intersection returns a new List containing the elements that are common between two input Lists.
-Example:
-list1 := Of[int](1, 2, 3)
-list2 := Of[int](2, 3, 4)
-Intersection(list1, list2) returns list[int]([2,3])
+MaxBy returns the greatest element of the list according to the given less function, wrapped in an Option.
+If several elements are equally great, the first one is returned. If the list is empty, it returns an empty Option.
+Examples:
+MaxBy(Of("bb", "a", "ccc"), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]("ccc")
+MaxBy(Empty[string](), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]{isPresent: false}
 */
-func Intersection[T any](list1 List[T], list2 List[T]) List[T] {
-	return Filter(list1, func(t T) bool {
-		return Contains(list2, t)
+func MaxBy[T any](list List[T], less func(T, T) bool) option.Option[T] {
+	return Reduce(list, func(max T, t T) T {
+		if less(max, t) {
+			return t
+		}
+		return max
 	})
 }
 
+func (list List[T]) MaxBy(less func(T, T) bool) option.Option[T] {
+	return MaxBy(list, less)
+}
+
 /*
-Difference returns a new List containing the elements that are present in the first input List but not in the second input List.
-Example:
-list1 := Of[int](1, 2, 3)
-list2 := Of[int](2, 3, 4)
-Difference(list1, list2) returns List[int]([1])
+Min returns the smallest element of a list of ordered values, wrapped in an Option.
+If the list is empty, it returns an empty Option.
+Examples:
+Min(Of(3, 1, 2)) returns Option[int](1)
+Min(Empty[int]()) returns Option[int]{isPresent: false}
 */
-func Difference[T any](list1 List[T], list2 List[T]) List[T] {
-	return Filter(list1, func(t T) bool {
-		return !Contains(list2, t)
-	})
+func Min[T constraints.Ordered](list List[T]) option.Option[T] {
+	return MinBy(list, func(a T, b T) bool { return a < b })
 }
 
 /*
-IsSublistOf returns true if all elements of the first input List are present in the second input List, false otherwise.
-Example:
-list1 := Of[int](1, 2, 3)
-list2 := Of[int](1, 2, 3, 4)
-IsSublistOf(list1, list2) returns true
+Max returns the greatest element of a list of ordered values, wrapped in an Option.
+If the list is empty, it returns an empty Option.
+Examples:
+Max(Of(3, 1, 2)) returns Option[int](3)
+Max(Empty[int]()) returns Option[int]{isPresent: false}
 */
-func IsSublistOf[T any](list1 List[T], list2 List[T]) bool {
-	return Forall(list1, func(t T) bool {
-		return Contains(list2, t)
-	})
+func Max[T constraints.Ordered](list List[T]) option.Option[T] {
+	return MaxBy(list, func(a T, b T) bool { return a < b })
 }
 
 /*
-IsSuperListOf returns true if all elements of the second input List are present in the first input List, false otherwise.
-Example:
+Sum returns the sum of the elements of a numeric list.
+Examples:
+Sum(Of(1, 2, 3)) returns 6
+Sum(Empty[int]()) returns 0
+*/
+func Sum[T constraints.Integer | constraints.Float](list List[T]) T {
+	return FoldMap(list, monoid.Sum[T](), func(t T) T { return t })
+}
+
+/*
+Product returns the product of the elements of a numeric list.
+Examples:
+Product(Of(2, 3, 4)) returns 24
+Product(Empty[int]()) returns 1
+*/
+func Product[T constraints.Integer | constraints.Float](list List[T]) T {
+	return FoldMap(list, monoid.Product[T](), func(t T) T { return t })
+}
+
+/*
+Average returns the arithmetic mean of the elements of a numeric list, wrapped in an Option.
+If the list is empty, it returns an empty Option.
+Examples:
+Average(Of(1, 2, 3, 4)) returns Option[float64](2.5)
+Average(Empty[int]()) returns Option[float64]{isPresent: false}
+*/
+func Average[T constraints.Integer | constraints.Float](list List[T]) option.Option[float64] {
+	if IsEmpty(list) {
+		return option.Empty[float64]()
+	}
+	sum := FoldMap(list, monoid.Sum[float64](), func(t T) float64 { return float64(t) })
+	return option.Pure(sum / float64(Len(list)))
+}
+
+/*
+Scan works like Fold but returns every intermediate accumulated value, starting with the root value.
+The resulting List always contains one more element than the input list.
+Examples:
+Scan(Of(1, 2, 3), 0, func(a int, b int) int { return a + b }) returns List[int]([0,1,3,6])
+Scan(Empty[int](), 10, func(a int, b int) int { return a + b }) returns List[int]([10])
+*/
+func Scan[T any, R any](list List[T], root R, f func(R, T) R) List[R] {
+	results := make([]R, 0, len(list.values)+1)
+	result := root
+	results = append(results, result)
+	for _, v := range list.values {
+		result = f(result, v)
+		results = append(results, result)
+	}
+	return Pure(results)
+}
+
+/*
+FlatMap applies a function that returns a List for each element of the input list, then concatenates the resulting lists.
+Example:
+FlatMap(Of(1, 2, 3), func(n int) List[int] { return Of(n, n * 2) }) returns List[int]([1,2,2,4,3,6])
+FlatMap(Empty[int](), func(n int) List[int] { return Of(n, n * 2) }) returns List[int]([])
+*/
+func FlatMap[T any, R any](list List[T], f func(T) List[R]) List[R] {
+	size := 0
+	lists := Fold(list, make([]List[R], 0, len(list.values)), func(lists []List[R], t T) []List[R] {
+		l := f(t)
+		size += len(l.values)
+		return append(lists, l)
+	})
+	return Pure(Fold(Pure(lists), make([]R, 0, size), func(results []R, l List[R]) []R {
+		return append(results, l.values...)
+	}))
+}
+
+/*
+Flatten returns a new List with all elements of the input List flattened into a single List.
+Example:
+Flatten(Of(Of(1, 2), Of(3, 4))) returns List[int]([1,2,3,4])
+*/
+func Flatten[T any](list List[List[T]]) List[T] {
+	return FlatMap(list, func(t List[T]) List[T] { return t })
+}
+
+/*
+Map applies a function to each element of the list and returns a new List with the results.
+Examples:
+Map(Of(1, 2, 3), func(n int) int { return n * n }) returns List[int]([1,4,9])
+Map(Empty[int](), func(n int) int { return n * n }) returns List[int]([])
+*/
+func Map[T any, R any](list List[T], f func(T) R) List[R] {
+	return Pure(Fold(list, make([]R, 0, len(list.values)), func(results []R, t T) []R {
+		return append(results, f(t))
+	}))
+}
+
+/*
+TraverseOption applies a function returning an Option to each element of the list and collects the values in a List.
+It stops at the first empty Option and returns an empty Option in that case.
+Examples:
+TraverseOption(Of(1, 2, 3), func(n int) option.Option[int] { return option.Pure(n * 2) }) returns Option(List[int]([2,4,6]))
+TraverseOption(Of(1, -2, 3), func(n int) option.Option[int] { return option.Filter(option.Pure(n), isPositive) }) returns Option[List[int]]{isPresent: false}
+*/
+func TraverseOption[T any, R any](list List[T], f func(T) option.Option[R]) option.Option[List[R]] {
+	results := make([]R, 0, len(list.values))
+	for _, v := range list.values {
+		r := f(v)
+		if r.IsEmpty() {
+			return option.Empty[List[R]]()
+		}
+		results = append(results, r.Get())
+	}
+	return option.Pure(Pure(results))
+}
+
+/*
+SequenceOption turns a List of Options into an Option of List, which is empty as soon as one of the Options is empty.
+Examples:
+SequenceOption(Of(option.Pure(1), option.Pure(2))) returns Option(List[int]([1,2]))
+SequenceOption(Of(option.Pure(1), option.Empty[int]())) returns Option[List[int]]{isPresent: false}
+*/
+func SequenceOption[T any](list List[option.Option[T]]) option.Option[List[T]] {
+	return TraverseOption(list, func(o option.Option[T]) option.Option[T] { return o })
+}
+
+/*
+TraverseEither applies a function returning an Either to each element of the list and collects the Right values in a List.
+It stops at the first Left value and returns it in that case.
+Examples:
+TraverseEither(Of(1, 2), func(n int) either.Either[string, int] { return either.Right[string, int](n * 2) }) returns Right(List[int]([2,4]))
+TraverseEither(Of(1, -2), func(n int) either.Either[string, int] { return either.Left[string, int]("negative") }) returns Left("negative")
+*/
+func TraverseEither[T any, L any, R any](list List[T], f func(T) either.Either[L, R]) either.Either[L, List[R]] {
+	results := make([]R, 0, len(list.values))
+	for _, v := range list.values {
+		e := f(v)
+		if e.IsLeft() {
+			return either.Left[L, List[R]](e.Left.Get())
+		}
+		results = append(results, e.Right.Get())
+	}
+	return either.Right[L](Pure(results))
+}
+
+/*
+SequenceEither turns a List of Eithers into an Either of List, holding the first Left value if there is one.
+Examples:
+SequenceEither(Of(either.Right[string, int](1), either.Right[string, int](2))) returns Right(List[int]([1,2]))
+SequenceEither(Of(either.Right[string, int](1), either.Left[string, int]("error"))) returns Left("error")
+*/
+func SequenceEither[L any, R any](list List[either.Either[L, R]]) either.Either[L, List[R]] {
+	return TraverseEither(list, func(e either.Either[L, R]) either.Either[L, R] { return e })
+}
+
+/*
+TraverseTry applies a function returning a Try to each element of the list and collects the successful results in a List.
+It stops at the first failed Try and returns its error in that case.
+Examples:
+TraverseTry(Of("1", "2"), func(s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) }) returns Success(List[int]([1,2]))
+TraverseTry(Of("1", "x"), func(s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) }) returns Fail(error)
+*/
+func TraverseTry[T any, R any](list List[T], f func(T) try.Try[R]) try.Try[List[R]] {
+	return either.Fold(TraverseEither(list, func(t T) either.Either[error, R] {
+		return f(t).ToEither()
+	}), try.Fail[List[R]], try.Success[List[R]])
+}
+
+/*
+SequenceTry turns a List of Trys into a Try of List, failing with the first error if there is one.
+Examples:
+SequenceTry(Of(try.Success(1), try.Success(2))) returns Success(List[int]([1,2]))
+SequenceTry(Of(try.Success(1), try.Fail[int](err))) returns Fail(err)
+*/
+func SequenceTry[T any](list List[try.Try[T]]) try.Try[List[T]] {
+	return TraverseTry(list, func(t try.Try[T]) try.Try[T] { return t })
+}
+
+/*
+ParOptions configures the parallel operations ParMap, ParFilter and MapParallelOrdered.
+Context cancels the operation when done, and defaults to context.Background() when nil.
+Parallelism is the maximum number of goroutines running the function at the same time,
+and defaults to runtime.GOMAXPROCS(0) when lower than 1.
+*/
+type ParOptions struct {
+	Context     context.Context
+	Parallelism int
+}
+
+/*
+ParMap applies a function to each element of the list using several goroutines and returns a new List with the results,
+in the same order as the input List. If the context is cancelled before all elements are handed to the goroutines,
+the remaining elements are skipped and it returns a failed Try holding the context error.
+A cancellation after the last element was handed over does not fail it, since every result is then computed.
+Example:
+ParMap(Of(1, 2, 3), func(n int) int { return n * n }, ParOptions{Parallelism: 2}) returns Success(List[int]([1,4,9]))
+*/
+func ParMap[T any, R any](list List[T], f func(T) R, opts ParOptions) try.Try[List[R]] {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	results := make([]R, len(list.values))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < parallelism && w < len(list.values); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = f(list.values[i])
+			}
+		}()
+	}
+	fed := func() bool {
+		defer close(indexes)
+		for i := range list.values {
+			select {
+			case <-ctx.Done():
+				return false
+			case indexes <- i:
+			}
+		}
+		return true
+	}()
+	wg.Wait()
+	if !fed {
+		return try.Fail[List[R]](ctx.Err())
+	}
+	return try.Success(Pure(results))
+}
+
+/*
+ParFilter evaluates the predicate function on each element of the list using several goroutines
+and returns a new List containing only the elements that satisfy it, in the same order as the input List.
+If the context is cancelled before all elements are processed, it returns a failed Try holding the context error.
+Example:
+ParFilter(Of(1, 2, 3, 4), func(n int) bool { return n % 2 == 0 }, ParOptions{}) returns Success(List[int]([2,4]))
+*/
+func ParFilter[T any](list List[T], f func(T) bool, opts ParOptions) try.Try[List[T]] {
+	return try.Map(ParMap(list, f, opts), func(keep List[bool]) List[T] {
+		results := make([]T, 0, len(list.values))
+		for i, v := range list.values {
+			if keep.values[i] {
+				results = append(results, v)
+			}
+		}
+		return Pure(results)
+	})
+}
+
+/*
+MapParallelOrdered applies a function to each element of the list in separate goroutines and emits the results
+on the returned channel in the order of the input list, as soon as each result and all the previous ones are available.
+At most opts.Parallelism elements are processed at the same time, and the channel is closed after the last result.
+A consumer that stops reading early must cancel opts.Context: the remaining elements are then skipped,
+the channel is closed and the goroutines are released once the results being computed are done.
+Example:
+for r := range MapParallelOrdered(Of(1, 2, 3), func(n int) int { return n * n }, ParOptions{Parallelism: 2}) { fmt.Println(r) } prints 1, 4 and 9
+*/
+func MapParallelOrdered[T any, R any](list List[T], f func(T) R, opts ParOptions) <-chan R {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	pending := make(chan chan R, parallelism-1)
+	results := make(chan R)
+	go func() {
+		defer close(pending)
+		for _, v := range list.values {
+			result := make(chan R, 1)
+			select {
+			case <-ctx.Done():
+				return
+			case pending <- result:
+			}
+			go func(v T) {
+				result <- f(v)
+			}(v)
+		}
+	}()
+	go func() {
+		defer close(results)
+		for result := range pending {
+			select {
+			case <-ctx.Done():
+				return
+			case results <- <-result:
+			}
+		}
+	}()
+	return results
+}
+
+/*
+Filter returns a new List containing only the elements that satisfy the given predicate function.
+Example: Filter(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns List[int]([2,4])
+*/
+func Filter[T any](list List[T], f func(T) bool) List[T] {
+	results := Fold(list, make([]T, 0, len(list.values)), func(results []T, t T) []T {
+		if f(t) {
+			return append(results, t)
+		}
+		return results
+	})
+	return Pure(results[:len(results):len(results)])
+}
+
+func (list List[T]) Filter(f func(T) bool) List[T] {
+	return Filter(list, f)
+}
+
+/*
+Find returns the first element that satisfies the given predicate function, wrapped in an Option.
+If no elements satisfy the predicate, it returns an empty Option.
+Examples:
+Find(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns Option[int](2)
+Find(myList, func(n int) bool { return n < 0 }) returns Option[int]()
+*/
+func Find[T any](list List[T], f func(T) bool) option.Option[T] {
+	return foldWhile(iterate(list), option.Empty[T](), func(found option.Option[T], t T) (option.Option[T], bool) {
+		if f(t) {
+			return option.Pure(t), false
+		}
+		return found, true
+	})
+}
+
+func (list List[T]) Find(f func(T) bool) option.Option[T] {
+	return Find(list, f)
+}
+
+/*
+Count returns the number of elements that satisfy the given predicate function.
+Example: Count(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns 2
+*/
+func Count[T any](list List[T], f func(T) bool) int {
+	return Fold(list, 0, func(count int, t T) int {
+		if f(t) {
+			return count + 1
+		}
+		return count
+	})
+}
+
+func (list List[T]) Count(f func(T) bool) int {
+	return Count(list, f)
+}
+
+/*
+CountBy returns the number of elements for each key extracted with the given key function.
+Example: CountBy(Of("a", "bb", "cc"), func(s string) int { return len(s) }) returns map[int]int{1: 1, 2: 2}
+*/
+func CountBy[T any, K comparable](list List[T], key func(T) K) map[K]int {
+	return Fold(list, map[K]int{}, func(counts map[K]int, t T) map[K]int {
+		counts[key(t)]++
+		return counts
+	})
+}
+
+/*
+IndexWhere returns the index of the first element that satisfies the given predicate function, wrapped in an Option.
+If no elements satisfy the predicate, it returns an empty Option.
+Examples:
+IndexWhere(Of(1, 2, 3, 4), func(n int) bool { return n % 2 == 0 }) returns Option[int](1)
+IndexWhere(Of(1, 2, 3, 4), func(n int) bool { return n < 0 }) returns Option[int]{isPresent: false}
+*/
+func IndexWhere[T any](list List[T], f func(T) bool) option.Option[int] {
+	for i, v := range list.values {
+		if f(v) {
+			return option.Pure(i)
+		}
+	}
+	return option.Empty[int]()
+}
+
+func (list List[T]) IndexWhere(f func(T) bool) option.Option[int] {
+	return IndexWhere(list, f)
+}
+
+/*
+LastIndexWhere returns the index of the last element that satisfies the given predicate function, wrapped in an Option.
+If no elements satisfy the predicate, it returns an empty Option.
+Examples:
+LastIndexWhere(Of(1, 2, 3, 4), func(n int) bool { return n % 2 == 0 }) returns Option[int](3)
+LastIndexWhere(Of(1, 2, 3, 4), func(n int) bool { return n < 0 }) returns Option[int]{isPresent: false}
+*/
+func LastIndexWhere[T any](list List[T], f func(T) bool) option.Option[int] {
+	for i := len(list.values) - 1; i >= 0; i-- {
+		if f(list.values[i]) {
+			return option.Pure(i)
+		}
+	}
+	return option.Empty[int]()
+}
+
+func (list List[T]) LastIndexWhere(f func(T) bool) option.Option[int] {
+	return LastIndexWhere(list, f)
+}
+
+/*
+Enumerated returns a new List pairing each element of the input List with its index.
+Example: Enumerated(Of("a", "b")) returns List[Tuple[int, string]]([(0, "a"), (1, "b")])
+*/
+func Enumerated[T any](list List[T]) List[tuple.Tuple[int, T]] {
+	results := make([]tuple.Tuple[int, T], len(list.values))
+	for i, v := range list.values {
+		results[i] = tuple.Pure(i, v)
+	}
+	return Pure(results)
+}
+
+/*
+ZipWithIndex is the same as Enumerated: it pairs each element of the input List with its index.
+Example: ZipWithIndex(Of("a", "b")) returns List[Tuple[int, string]]([(0, "a"), (1, "b")])
+*/
+func ZipWithIndex[T any](list List[T]) List[tuple.Tuple[int, T]] {
+	return Enumerated(list)
+}
+
+/*
+MapIndexed applies a function to each element of the list and its index and returns a new List with the results.
+Example: MapIndexed(Of("a", "b"), func(i int, s string) string { return strconv.Itoa(i) + s }) returns List[string](["0a","1b"])
+*/
+func MapIndexed[T any, R any](list List[T], f func(int, T) R) List[R] {
+	results := make([]R, len(list.values))
+	for i, v := range list.values {
+		results[i] = f(i, v)
+	}
+	return Pure(results)
+}
+
+/*
+ForEachIndexed applies a function to each element of the list and its index for its side effects.
+Example: ForEachIndexed(Of("a", "b"), func(i int, s string) { fmt.Println(i, s) }) prints "0 a" and "1 b"
+*/
+func ForEachIndexed[T any](list List[T], f func(int, T)) {
+	for i, v := range list.values {
+		f(i, v)
+	}
+}
+
+func (list List[T]) ForEachIndexed(f func(int, T)) {
+	ForEachIndexed(list, f)
+}
+
+/*
+WithIndexWhere returns the elements that satisfy the given predicate function, each paired with its index in the input List.
+Example: WithIndexWhere(Of(1, 2, 3, 4), func(n int) bool { return n % 2 == 0 }) returns List[Tuple[int, int]]([(1, 2), (3, 4)])
+*/
+func WithIndexWhere[T any](list List[T], f func(T) bool) List[tuple.Tuple[int, T]] {
+	return Filter(Enumerated(list), func(t tuple.Tuple[int, T]) bool {
+		return f(t.Get2())
+	})
+}
+
+/*
+MaxIndexBy returns the index of the greatest element according to the given less function, wrapped in an Option.
+If several elements are equally great, the index of the first one is returned. If the list is empty, it returns an empty Option.
+Example: MaxIndexBy(Of(3, 9, 1, 9), func(a int, b int) bool { return a < b }) returns Option[int](1)
+*/
+func MaxIndexBy[T any](list List[T], less func(T, T) bool) option.Option[int] {
+	return option.Map(MaxBy(Enumerated(list), func(a tuple.Tuple[int, T], b tuple.Tuple[int, T]) bool {
+		return less(a.Get2(), b.Get2())
+	}), tuple.Get1[int, T])
+}
+
+/*
+IndexOf returns the index of the first occurrence of the given value, wrapped in an Option.
+It uses equal.Equals to compare the elements. If the value is absent, it returns an empty Option.
+Examples:
+IndexOf(Of(1, 2, 3, 2), 2) returns Option[int](1)
+IndexOf(Of(1, 2, 3, 2), 4) returns Option[int]{isPresent: false}
+*/
+func IndexOf[T any](list List[T], value T) option.Option[int] {
+	return IndexWhere(list, func(t T) bool { return equal.Equals(t, value) })
+}
+
+func (list List[T]) IndexOf(value T) option.Option[int] {
+	return IndexOf(list, value)
+}
+
+/*
+LastIndexOf returns the index of the last occurrence of the given value, wrapped in an Option.
+It uses equal.Equals to compare the elements. If the value is absent, it returns an empty Option.
+Examples:
+LastIndexOf(Of(1, 2, 3, 2), 2) returns Option[int](3)
+LastIndexOf(Of(1, 2, 3, 2), 4) returns Option[int]{isPresent: false}
+*/
+func LastIndexOf[T any](list List[T], value T) option.Option[int] {
+	return LastIndexWhere(list, func(t T) bool { return equal.Equals(t, value) })
+}
+
+func (list List[T]) LastIndexOf(value T) option.Option[int] {
+	return LastIndexOf(list, value)
+}
+
+/*
+AnyMatch returns true if any element in the list satisfies the given predicate function, false otherwise.
+Examples:
+AnyMatch(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns true
+AnyMatch(Of(1, 2, 3, 4, 5), func(n int) bool { return n < 0 }) returns false
+*/
+func AnyMatch[T any](list List[T], f func(T) bool) bool {
+	return Find(list, f).IsPresent()
+}
+
+func (list List[T]) AnyMatch(f func(T) bool) bool {
+	return AnyMatch(list, f)
+}
+
+/*
+Forall returns true if all elements in the input List satisfy the given predicate function, false otherwise.
+Examples:
+Forall(Of(1, 2, 3), func(n int) bool { return n > 0 }) returns true
+Forall(Of(1, 2, 3), func(n int) bool { return n > 1 }) returns false
+*/
+func Forall[T any](list List[T], f func(T) bool) bool {
+	return !AnyMatch(list, func(t T) bool { return !f(t) })
+}
+
+func (list List[T]) Forall(f func(T) bool) bool {
+	return Forall(list, f)
+}
+
+/*
+Remove returns a new List with all elements of the input List that do not satisfy the given predicate function.
+Examples:
+Remove(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns List[int]([1,3,5])
+Remove(Of(), func(n int) bool { return n % 2 == 0 }) returns List[int]([])
+*/
+func Remove[T any](list List[T], f func(T) bool) List[T] {
+	return Filter(list, func(t T) bool { return !f(t) })
+}
+
+func (list List[T]) Remove(f func(T) bool) List[T] {
+	return Remove(list, f)
+}
+
+/*
+Copy returns a new List with all elements of the input List copied.
+Example:
+Copy(Of(1, 2, 3)) returns List[int]([1,2,3])
+*/
+func Copy[T any](list List[T]) List[T] {
+	copyValues := make([]T, len(list.values))
+	copy(copyValues, list.values)
+	return Pure(copyValues)
+}
+
+func (list List[T]) Copy() List[T] {
+	return Copy(list)
+}
+
+/*
+ListStats describes the storage of a List, for diagnosing copy and sharing behavior.
+Backing is the address of the first element visible to the List in its backing array, or 0 when it has no capacity.
+Bytes is the approximate shallow footprint of the reachable part of the backing array: memory referenced by the elements
+themselves (strings, slices, pointers, ...) is not counted.
+*/
+type ListStats struct {
+	Len         int
+	Cap         int
+	ElementSize uintptr
+	Bytes       uintptr
+	Backing     uintptr
+}
+
+/*
+Stats returns the storage statistics of the given list.
+Example:
+Stats(Pure(make([]int64, 2, 4))) returns ListStats{Len: 2, Cap: 4, ElementSize: 8, Bytes: 32, Backing: <address>}
+*/
+func Stats[T any](list List[T]) ListStats {
+	var zero T
+	size := unsafe.Sizeof(zero)
+	stats := ListStats{
+		Len:         len(list.values),
+		Cap:         cap(list.values),
+		ElementSize: size,
+		Bytes:       uintptr(cap(list.values)) * size,
+	}
+	if cap(list.values) > 0 {
+		stats.Backing = uintptr(unsafe.Pointer(unsafe.SliceData(list.values)))
+	}
+	return stats
+}
+
+func (list List[T]) Stats() ListStats {
+	return Stats(list)
+}
+
+/*
+SharesBacking returns true if the reachable parts of the backing arrays of both lists overlap,
+meaning that appending to one of them may be observed through the other.
+Lists of zero-sized elements never share backing.
+Examples:
+l := Of(1, 2, 3)
+SharesBacking(l, Tail(l)) returns true
+SharesBacking(l, Copy(l)) returns false
+*/
+func SharesBacking[T any](list1 List[T], list2 List[T]) bool {
+	s1, s2 := Stats(list1), Stats(list2)
+	if s1.Bytes == 0 || s2.Bytes == 0 {
+		return false
+	}
+	return s1.Backing < s2.Backing+s2.Bytes && s2.Backing < s1.Backing+s1.Bytes
+}
+
+func (list List[T]) SharesBacking(other List[T]) bool {
+	return SharesBacking(list, other)
+}
+
+/*
+Builder accumulates elements with amortized O(1) appends and freezes them into an immutable List.
+A Builder is mutable and not safe for concurrent use. The zero value is an empty Builder ready to use.
+Example:
+b := NewBuilder[int](3)
+b.Add(1)
+b.AddAll(Of(2, 3))
+b.Build() returns List[int]([1,2,3])
+*/
+type Builder[T any] struct {
+	values []T
+}
+
+/*
+NewBuilder creates a new empty Builder with room for the given number of elements.
+Example: NewBuilder[int](10).Len() returns 0
+*/
+func NewBuilder[T any](capacity int) *Builder[T] {
+	return &Builder[T]{values: make([]T, 0, capacity)}
+}
+
+/*
+Add appends the given values to the Builder and returns it for chaining.
+Example: NewBuilder[int](0).Add(1, 2).Build() returns List[int]([1,2])
+*/
+func (b *Builder[T]) Add(values ...T) *Builder[T] {
+	b.values = append(b.values, values...)
+	return b
+}
+
+/*
+AddAll appends all elements of the given list to the Builder and returns it for chaining.
+Example: NewBuilder[int](0).AddAll(Of(1, 2)).Build() returns List[int]([1,2])
+*/
+func (b *Builder[T]) AddAll(list List[T]) *Builder[T] {
+	return b.Add(list.values...)
+}
+
+/*
+Len returns the number of elements added to the Builder so far.
+Example: NewBuilder[int](0).Add(1, 2).Len() returns 2
+*/
+func (b *Builder[T]) Len() int {
+	return len(b.values)
+}
+
+/*
+Build returns a List holding the elements added so far. The capacity of the List is clipped to its length,
+so elements added to the Builder afterwards are never observed through, nor written into, the built List.
+Example: NewBuilder[int](10).Add(1, 2).Build() returns List[int]([1,2])
+*/
+func (b *Builder[T]) Build() List[T] {
+	if b.values == nil {
+		return Empty[T]()
+	}
+	return Pure(b.values[:len(b.values):len(b.values)])
+}
+
+/*
+Sort returns a new List with all elements of the input List sorted according to the given comparison function.
+The sort is not stable: equal elements may be reordered. Use SortStable or SortStableBy when their order matters.
+Example:
+Sort(Of(3, 1, 4, 1, 5, 9), func(a int, b int) bool { return a < b }) returns List[int]([1,1,3,4,5,9])
+*/
+func Sort[T any](list List[T], isInOrder func(T, T) bool) List[T] {
+	copyValues := make([]T, len(list.values))
+	copy(copyValues, list.values)
+	sort.Slice(copyValues, func(i, j int) bool {
+		return isInOrder(copyValues[i], copyValues[j])
+	})
+	return Pure(copyValues)
+}
+
+func (list List[T]) Sort(isInOrder func(T, T) bool) List[T] {
+	return Sort(list, isInOrder)
+}
+
+/*
+SortStable works like Sort but keeps equal elements in their original order.
+Example:
+SortStable(Of("bb", "a", "cc", "d"), func(a string, b string) bool { return len(a) < len(b) }) returns List[string](["a","d","bb","cc"])
+*/
+func SortStable[T any](list List[T], isInOrder func(T, T) bool) List[T] {
+	copyValues := Copy(list).values
+	sort.SliceStable(copyValues, func(i, j int) bool {
+		return isInOrder(copyValues[i], copyValues[j])
+	})
+	return Pure(copyValues)
+}
+
+func (list List[T]) SortStable(isInOrder func(T, T) bool) List[T] {
+	return SortStable(list, isInOrder)
+}
+
+/*
+SortBy returns a new List with all elements of the input List sorted in ascending order of the key computed by the given function.
+The sort is not stable: elements with equal keys may be reordered.
+Example:
+SortBy(Of("ccc", "a", "bb"), func(s string) int { return len(s) }) returns List[string](["a","bb","ccc"])
+*/
+func SortBy[T any, K constraints.Ordered](list List[T], key func(T) K) List[T] {
+	return Sort(list, func(a T, b T) bool { return key(a) < key(b) })
+}
+
+/*
+SortStableBy works like SortBy but is guaranteed to be stable: elements with equal keys keep their original relative order,
+so sorting the same input always produces the same output.
+Example:
+SortStableBy(Of("bb", "a", "cc", "d"), func(s string) int { return len(s) }) returns List[string](["a","d","bb","cc"])
+*/
+func SortStableBy[T any, K constraints.Ordered](list List[T], key func(T) K) List[T] {
+	return SortStable(list, func(a T, b T) bool { return key(a) < key(b) })
+}
+
+/*
+Sorted returns a new List with all elements of the input List sorted in ascending natural order.
+Example:
+Sorted(Of(3, 1, 2)) returns List[int]([1,2,3])
+*/
+func Sorted[T constraints.Ordered](list List[T]) List[T] {
+	return Sort(list, func(a T, b T) bool { return a < b })
+}
+
+/*
+IsSorted returns true if the elements of the list are sorted according to the given comparison function.
+Examples:
+IsSorted(Of(1, 2, 2, 3), func(a int, b int) bool { return a < b }) returns true
+IsSorted(Of(1, 3, 2), func(a int, b int) bool { return a < b }) returns false
+*/
+func IsSorted[T any](list List[T], isInOrder func(T, T) bool) bool {
+	for i := 1; i < len(list.values); i++ {
+		if isInOrder(list.values[i], list.values[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (list List[T]) IsSorted(isInOrder func(T, T) bool) bool {
+	return IsSorted(list, isInOrder)
+}
+
+/*
+Shuffle returns a new List with the elements of the input List in a random order drawn from the given source.
+Using a source with a fixed seed gives reproducible results.
+Example:
+Shuffle(Of(1, 2, 3, 4), rand.New(rand.NewSource(42))) returns the same permutation of List[int]([1,2,3,4]) on every run
+*/
+func Shuffle[T any](list List[T], random *rand.Rand) List[T] {
+	return Sample(list, Len(list), random)
+}
+
+func (list List[T]) Shuffle(random *rand.Rand) List[T] {
+	return Shuffle(list, random)
+}
+
+/*
+Sample returns a new List with n elements picked at random, without replacement, from the input List.
+If n is greater than the length of the list, all elements are returned in a random order.
+Example:
+Sample(Of(1, 2, 3, 4), 2, rand.New(rand.NewSource(42))) returns the same two elements of List[int]([1,2,3,4]) on every run
+*/
+func Sample[T any](list List[T], n int, random *rand.Rand) List[T] {
+	if n > Len(list) {
+		n = Len(list)
+	}
+	if n < 0 {
+		n = 0
+	}
+	values := Copy(list).values
+	for i := 0; i < n; i++ {
+		j := i + random.Intn(len(values)-i)
+		values[i], values[j] = values[j], values[i]
+	}
+	return Pure(values[:n:n])
+}
+
+func (list List[T]) Sample(n int, random *rand.Rand) List[T] {
+	return Sample(list, n, random)
+}
+
+/*
+ToArray returns a new slice with all elements of the input List.
+Example:
+ToArray(Of(1, 2, 3)) returns []int{1, 2, 3}
+*/
+func ToArray[T any](list List[T]) []T {
+	return list.values
+}
+
+func (list List[T]) ToArray() []T {
+	return ToArray(list)
+}
+
+/*
+ToMap returns a new map associating each element of the list with the result of f applied to it.
+If the list contains the same key several times, f is applied to each occurrence and the last result is kept.
+Example: ToMap(Of("a", "bb"), func(s string) int { return len(s) }) returns map[string]int{"a": 1, "bb": 2}
+*/
+func ToMap[K comparable, V any](list List[K], f func(K) V) map[K]V {
+	result := make(map[K]V, Len(list))
+	for _, k := range list.values {
+		result[k] = f(k)
+	}
+	return result
+}
+
+/*
+Join returns a string made of the elements of the list converted with f and separated by sep.
+Example:
+Join(Of(1, 2, 3), "-", func(n int) string { return strconv.Itoa(n * 2) }) returns "2-4-6"
+*/
+func Join[T any](list List[T], sep string, f func(T) string) string {
+	parts := make([]string, len(list.values))
+	for i, v := range list.values {
+		parts[i] = f(v)
+	}
+	return strings.Join(parts, sep)
+}
+
+func (list List[T]) Join(sep string, f func(T) string) string {
+	return Join(list, sep, f)
+}
+
+/*
+MkString returns a string made of the elements of the list separated by sep.
+The elements are formatted with fmt.Sprint, so their String method is used when they implement fmt.Stringer.
+Example:
+MkString(Of("a", "b", "c"), ", ") returns "a, b, c"
+*/
+func MkString[T any](list List[T], sep string) string {
+	return MkStringWith(list, "", sep, "")
+}
+
+func (list List[T]) MkString(sep string) string {
+	return MkString(list, sep)
+}
+
+/*
+MkStringWith works like MkString but encloses the result between prefix and suffix.
+Example:
+MkStringWith(Of(1, 2, 3), "[", ", ", "]") returns "[1, 2, 3]"
+*/
+func MkStringWith[T any](list List[T], prefix string, sep string, suffix string) string {
+	return prefix + Join(list, sep, func(t T) string { return fmt.Sprint(t) }) + suffix
+}
+
+func (list List[T]) MkStringWith(prefix string, sep string, suffix string) string {
+	return MkStringWith(list, prefix, sep, suffix)
+}
+
+/*
+Contains returns true if the given value is present in the input List, false otherwise.
+It uses the Equals method of the elements in the List to compare for equality.
+Example:
+Contains(Of[int](1, 2, 3), 3) returns true
+Contains(Of[int](1, 2, 3), 4) returns false
+*/
+func Contains[T any](list List[T], value T) bool {
+	return AnyMatch(list, func(t T) bool {
+		return equal.Equals(t, value)
+	})
+}
+
+/*
+ContainsAll returns true if every given value is present in the input List, in a single pass over the List.
+It uses the Equals method of the elements in the List to compare for equality.
+Examples:
+ContainsAll(Of(1, 2, 3), 3, 1) returns true
+ContainsAll(Of(1, 2, 3), 3, 4) returns false
+ContainsAll(Of(1, 2, 3)) returns true
+*/
+func ContainsAll[T any](list List[T], values ...T) bool {
+	found := make([]bool, len(values))
+	remaining := len(values)
+	for _, t := range list.values {
+		if remaining == 0 {
+			break
+		}
+		for i, value := range values {
+			if !found[i] && equal.Equals(t, value) {
+				found[i] = true
+				remaining--
+			}
+		}
+	}
+	return remaining == 0
+}
+
+func (list List[T]) ContainsAll(values ...T) bool {
+	return ContainsAll(list, values...)
+}
+
+/*
+ContainsAny returns true if at least one of the given values is present in the input List, in a single pass over the List.
+It uses the Equals method of the elements in the List to compare for equality.
+Examples:
+ContainsAny(Of(1, 2, 3), 4, 3) returns true
+ContainsAny(Of(1, 2, 3), 4, 5) returns false
+ContainsAny(Of(1, 2, 3)) returns false
+*/
+func ContainsAny[T any](list List[T], values ...T) bool {
+	return AnyMatch(list, func(t T) bool {
+		return AnyMatch(Pure(values), func(value T) bool {
+			return equal.Equals(t, value)
+		})
+	})
+}
+
+func (list List[T]) ContainsAny(values ...T) bool {
+	return ContainsAny(list, values...)
+}
+
+/*
+Distinct returns a new List with all duplicate elements removed from the input List.
+It uses the Equals method of the elements in the List to compare for equality.
+When equal.Comparable holds for T, duplicates are found with a map in linear time instead of comparing every pair of elements.
+Example:
+Distinct(Of(1, 2, 3, 3)) returns List[int]([1, 2, 3])
+*/
+func Distinct[T any](list List[T]) List[T] {
+	if equal.Comparable[T]() {
+		seen := make(map[interface{}]struct{}, Len(list))
+		return Pure(Fold(list, []T{}, func(unique []T, value T) []T {
+			if _, found := seen[value]; found {
+				return unique
+			}
+			seen[value] = struct{}{}
+			return append(unique, value)
+		}))
+	}
+	return Pure(Fold(list, []T{}, func(unique []T, value T) []T {
+		if Contains(Pure(unique), value) {
+			return unique
+		}
+		return append(unique, value)
+	}))
+}
+
+func (list List[T]) Distinct() List[T] {
+	return Distinct(list)
+}
+
+/*
+HasDuplicates returns true if the list contains at least two equal elements, according to equal.Equals.
+It stops at the first duplicate found.
+Examples:
+HasDuplicates(Of(1, 2, 1)) returns true
+HasDuplicates(Of(1, 2, 3)) returns false
+*/
+func HasDuplicates[T any](list List[T]) bool {
+	found := false
+	eachFirstIndex(list, func(i int, first int) bool {
+		found = i != first
+		return !found
+	})
+	return found
+}
+
+func (list List[T]) HasDuplicates() bool {
+	return HasDuplicates(list)
+}
+
+/*
+Duplicates returns a new List of the elements occurring more than once in the list, according to equal.Equals.
+Each of them appears once, at the position of its first occurrence.
+Example: Duplicates(Of(3, 1, 2, 1, 3, 3)) returns List[int]([3,1])
+*/
+func Duplicates[T any](list List[T]) List[T] {
+	repeated := make([]bool, len(list.values))
+	eachFirstIndex(list, func(i int, first int) bool {
+		repeated[first] = repeated[first] || i != first
+		return true
+	})
+	duplicates := []T{}
+	for i, v := range list.values {
+		if repeated[i] {
+			duplicates = append(duplicates, v)
+		}
+	}
+	return Pure(duplicates)
+}
+
+func (list List[T]) Duplicates() List[T] {
+	return Duplicates(list)
+}
+
+/*
+eachFirstIndex calls f with the index of each element of the list and the index of the first element equal to it,
+until f returns false. Elements are looked up in a map when equal.Comparable holds for T, and grouped by hash.Of
+before being compared with equal.Equals otherwise, so it runs in linear time in both cases unless hashes collide.
+*/
+func eachFirstIndex[T any](list List[T], f func(i int, first int) bool) {
+	if equal.Comparable[T]() {
+		firsts := make(map[interface{}]int, Len(list))
+		for i, v := range list.values {
+			first, found := firsts[v]
+			if !found {
+				firsts[v], first = i, i
+			}
+			if !f(i, first) {
+				return
+			}
+		}
+		return
+	}
+	buckets := make(map[uint64][]int, Len(list))
+	for i, v := range list.values {
+		h := hash.Of(v)
+		first := i
+		for _, j := range buckets[h] {
+			if equal.Equals(list.values[j], v) {
+				first = j
+				break
+			}
+		}
+		if first == i {
+			buckets[h] = append(buckets[h], i)
+		}
+		if !f(i, first) {
+			return
+		}
+	}
+}
+
+/*
+GroupAdjacent returns a new List of the runs of consecutive elements of the input List for which eq holds
+between each element and the previous one, in their original order.
+Example:
+GroupAdjacent(Of(1, 1, 2, 3, 3, 1), func(a int, b int) bool { return a == b }) returns List[List[int]]([[1,1],[2],[3,3],[1]])
+*/
+func GroupAdjacent[T any](list List[T], eq func(T, T) bool) List[List[T]] {
+	groups := []List[T]{}
+	start := 0
+	for i := 1; i <= len(list.values); i++ {
+		if i == len(list.values) || !eq(list.values[i-1], list.values[i]) {
+			groups = append(groups, Pure(list.values[start:i:i]))
+			start = i
+		}
+	}
+	return Pure(groups)
+}
+
+/*
+DistinctConsecutive returns a new List where each run of consecutive equal elements of the input List is collapsed into its first element.
+It uses the Equals method of the elements in the List to compare for equality.
+Example:
+DistinctConsecutive(Of(1, 1, 2, 3, 3, 1)) returns List[int]([1,2,3,1])
+*/
+func DistinctConsecutive[T any](list List[T]) List[T] {
+	results := make([]T, 0, len(list.values))
+	for i, t := range list.values {
+		if i == 0 || !equal.Equals(list.values[i-1], t) {
+			results = append(results, t)
+		}
+	}
+	return Pure(results)
+}
+
+func (list List[T]) DistinctConsecutive() List[T] {
+	return DistinctConsecutive(list)
+}
+
+/*
+Intersection returns a new List containing the elements that are common between two input Lists.
+Example:
+list1 := Of[int](1, 2, 3)
+list2 := Of[int](2, 3, 4)
+Intersection(list1, list2) returns list[int]([2,3])
+*/
+func Intersection[T any](list1 List[T], list2 List[T]) List[T] {
+	return Filter(list1, func(t T) bool {
+		return Contains(list2, t)
+	})
+}
+
+/*
+Difference returns a new List containing the elements that are present in the first input List but not in the second input List.
+Example:
+list1 := Of[int](1, 2, 3)
+list2 := Of[int](2, 3, 4)
+Difference(list1, list2) returns List[int]([1])
+*/
+func Difference[T any](list1 List[T], list2 List[T]) List[T] {
+	return Filter(list1, func(t T) bool {
+		return !Contains(list2, t)
+	})
+}
+
+/*
+IsSublistOf returns true if all elements of the first input List are present in the second input List, false otherwise.
+Example:
+list1 := Of[int](1, 2, 3)
+list2 := Of[int](1, 2, 3, 4)
+IsSublistOf(list1, list2) returns true
+*/
+func IsSublistOf[T any](list1 List[T], list2 List[T]) bool {
+	return Forall(list1, func(t T) bool {
+		return Contains(list2, t)
+	})
+}
+
+/*
+IsSuperListOf returns true if all elements of the second input List are present in the first input List, false otherwise.
+Example:
 list1 := Of[int](1, 2, 3, 4)
 list2 := Of[int](1, 2, 3)
 IsSuperListOf(list1, list2) returns true
@@ -402,17 +1799,105 @@ func (list List[T]) Reverse() List[T] {
 	return Reverse(list)
 }
 
-func (list List[T]) Equals(other interface{}) bool {
-	if ol, ok := other.(List[T]); ok {
-		if len(list.values) != len(ol.values) {
+/*
+Equal returns true if both lists have the same length and eq holds for every pair of elements at the same index.
+It stops at the first mismatch and is useful for element types whose equality is not covered by equal.Equals.
+Examples:
+Equal(Of(1, 2, 3), Of(1, 2, 3), func(a int, b int) bool { return a == b }) returns true
+Equal(Of(1, 2, 3), Of(1, 3, 2), func(a int, b int) bool { return a == b }) returns false
+*/
+func Equal[T any](list1 List[T], list2 List[T], eq func(T, T) bool) bool {
+	if Len(list1) != Len(list2) {
+		return false
+	}
+	for i := range list1.values {
+		if !eq(list1.values[i], list2.values[i]) {
 			return false
 		}
-		for i := range list.values {
-			if equal.Equals(list.values[i], ol.values[i]) {
-				return false
-			}
-		}
-		return true
+	}
+	return true
+}
+
+/*
+Equals returns true if other is a List with the same elements in the same order, compared with equal.Equals.
+Example: Of(1, 2, 3).Equals(Of(1, 2, 3)) returns true.
+*/
+func (list List[T]) Equals(other interface{}) bool {
+	if ol, ok := other.(List[T]); ok {
+		return Equal(list, ol, func(a T, b T) bool {
+			return equal.Equals(a, b)
+		})
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the list consistent with Equals, implementing hash.Hashable.
+Example: Of(1, 2).HashCode() == Of(1, 2).HashCode() returns true
+*/
+func (list List[T]) HashCode() uint64 {
+	hashes := make([]uint64, len(list.values))
+	for i, v := range list.values {
+		hashes[i] = hash.Of(v)
+	}
+	return hash.Combine(hashes...)
+}
+
+/*
+MarshalJSON encodes the list as a plain JSON array. An empty list, including the zero List, encodes as [] rather than null.
+Examples:
+json.Marshal(Of(1, 2, 3)) returns []byte("[1,2,3]")
+json.Marshal(List[int]{}) returns []byte("[]")
+*/
+func (list List[T]) MarshalJSON() ([]byte, error) {
+	if list.values == nil {
+		return json.Marshal([]T{})
+	}
+	return json.Marshal(list.values)
+}
+
+/*
+UnmarshalJSON decodes a JSON array into the list, replacing its elements.
+Example: json.Unmarshal([]byte("[1,2,3]"), &l) sets l to List[int]([1,2,3])
+*/
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	list.values = values
+	return nil
+}
+
+/*
+String returns a readable representation of the list, implementing fmt.Stringer.
+Example: Of(1, 2, 3).String() returns "List(1, 2, 3)"
+*/
+func (list List[T]) String() string {
+	return MkStringWith(list, "List(", ", ", ")")
+}
+
+/*
+GobEncode encodes the elements of the list with encoding/gob, implementing gob.GobEncoder.
+Example: gob.NewEncoder(w).Encode(Of(1, 2, 3)) writes the list to w
+*/
+func (list List[T]) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(list.values); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+/*
+GobDecode decodes elements encoded by GobEncode into the list, replacing its elements, implementing gob.GobDecoder.
+Example: gob.NewDecoder(r).Decode(&l) reads a list from r into l
+*/
+func (list *List[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	list.values = values
+	return nil
+}
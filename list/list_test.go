@@ -0,0 +1,432 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Sugther/go-structs/equal"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// ints turns fuzzing bytes into the elements of a list, with enough repetitions to exercise Distinct.
+func ints(data []byte) []int {
+	values := make([]int, len(data))
+	for i, b := range data {
+		values[i] = int(b % 16)
+	}
+	return values
+}
+
+func addSeeds(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{7})
+	f.Add([]byte{1, 2, 3})
+	f.Add([]byte{3, 3, 1, 2, 1, 15})
+}
+
+func FuzzFold(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := ints(data)
+		want := []int{}
+		for _, v := range values {
+			want = append(want, v)
+		}
+		got := Fold(Pure(values), []int{}, func(acc []int, v int) []int { return append(acc, v) })
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Fold(%v) visited %v, want %v", values, got, want)
+		}
+	})
+}
+
+func FuzzMap(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := ints(data)
+		want := make([]int, 0, len(values))
+		for _, v := range values {
+			want = append(want, v*v)
+		}
+		got := Map(Pure(values), func(v int) int { return v * v }).ToArray()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Map(%v) returns %v, want %v", values, got, want)
+		}
+	})
+}
+
+func FuzzFilter(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := ints(data)
+		want := []int{}
+		for _, v := range values {
+			if v%2 == 1 {
+				want = append(want, v)
+			}
+		}
+		got := Filter(Pure(values), func(v int) bool { return v%2 == 1 }).ToArray()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Filter(%v) returns %v, want %v", values, got, want)
+		}
+	})
+}
+
+func FuzzDistinct(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := ints(data)
+		want := []int{}
+		for _, v := range values {
+			found := false
+			for _, w := range want {
+				found = found || v == w
+			}
+			if !found {
+				want = append(want, v)
+			}
+		}
+		got := Distinct(Pure(values)).ToArray()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Distinct(%v) returns %v, want %v", values, got, want)
+		}
+	})
+}
+
+func TestFoldIncludesLastElement(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   int
+	}{
+		{"empty", []int{}, 0},
+		{"single element", []int{5}, 5},
+		{"last element", []int{1, 2, 40}, 43},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := func(a int, b int) int { return a + b }
+			if got := Fold(Pure(tt.values), 0, sum); got != tt.want {
+				t.Errorf("Fold(%v) returns %d, want %d", tt.values, got, tt.want)
+			}
+			last := Map(Pure(tt.values), func(v int) int { return v }).Last()
+			if len(tt.values) > 0 && last.Get() != tt.values[len(tt.values)-1] {
+				t.Errorf("Map(%v) loses the last element: Last returns %v", tt.values, last)
+			}
+		})
+	}
+}
+
+const benchmarkSize = 100_000
+
+func benchmarkValues() []int {
+	values := make([]int, benchmarkSize)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func BenchmarkMap(b *testing.B) {
+	values := benchmarkValues()
+	l := Pure(values)
+	b.Run("List", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(l, func(v int) int { return v * 2 })
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			results := make([]int, len(values))
+			for j, v := range values {
+				results[j] = v * 2
+			}
+		}
+	})
+}
+
+func BenchmarkFilter(b *testing.B) {
+	values := benchmarkValues()
+	l := Pure(values)
+	b.Run("List", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Filter(l, func(v int) bool { return v%2 == 0 })
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			results := make([]int, 0, len(values))
+			for _, v := range values {
+				if v%2 == 0 {
+					results = append(results, v)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	values := benchmarkValues()
+	l := Pure(values)
+	b.Run("List", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FlatMap(l, func(v int) List[int] { return Of(v, v) })
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			results := make([]int, 0, 2*len(values))
+			for _, v := range values {
+				results = append(results, []int{v, v}...)
+			}
+		}
+	})
+}
+
+// account implements Equals while ignoring its label, to check that List equality uses it.
+type account struct {
+	id    int
+	label string
+}
+
+func (a account) Equals(other interface{}) bool {
+	o, ok := other.(account)
+	return ok && o.id == a.id
+}
+
+func TestEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  interface{ Equals(interface{}) bool }
+		other interface{}
+		want  bool
+	}{
+		{"same elements", Of(1, 2, 3), Of(1, 2, 3), true},
+		{"different lengths", Of(1, 2, 3), Of(1, 2), false},
+		{"longer other", Of(1, 2), Of(1, 2, 3), false},
+		{"zero List and Empty", List[int]{}, Empty[int](), true},
+		{"Empty and zero List", Empty[int](), List[int]{}, true},
+		{"first element differs", Of(0, 2, 3), Of(1, 2, 3), false},
+		{"last element differs", Of(1, 2, 3), Of(1, 2, 4), false},
+		{"custom Equals ignoring a field", Of(account{1, "a"}, account{2, "b"}), Of(account{1, "x"}, account{2, "y"}), true},
+		{"custom Equals on a differing element", Of(account{1, "a"}), Of(account{2, "a"}), false},
+		{"other is a slice", Of(1, 2), []int{1, 2}, false},
+		{"other is a List of another type", Of(1, 2), Of[int64](1, 2), false},
+		{"other is nil", Of(1), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.list.Equals(tt.other); got != tt.want {
+				t.Errorf("%v.Equals(%v) returns %v, want %v", tt.list, tt.other, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualStopsAtFirstMismatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		list1     List[int]
+		list2     List[int]
+		want      bool
+		wantCalls int
+	}{
+		{"equal lists", Of(1, 2, 3), Of(1, 2, 3), true, 3},
+		{"first mismatch", Of(0, 2, 3), Of(1, 2, 3), false, 1},
+		{"middle mismatch", Of(1, 0, 3), Of(1, 2, 3), false, 2},
+		{"last mismatch", Of(1, 2, 0), Of(1, 2, 3), false, 3},
+		{"different lengths", Of(1, 2), Of(1, 2, 3), false, 0},
+		{"both empty", Empty[int](), List[int]{}, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got := Equal(tt.list1, tt.list2, func(a int, b int) bool {
+				calls++
+				return a == b
+			})
+			if got != tt.want || calls != tt.wantCalls {
+				t.Errorf("Equal(%v, %v) returns %v after %d calls, want %v after %d calls",
+					tt.list1, tt.list2, got, calls, tt.want, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"ints", Range(0, 10, 3).ToArray(), []int{0, 3, 6, 9}},
+		{"ints counting down", Range(5, 0, -2).ToArray(), []int{5, 3, 1}},
+		{"wrong direction", Range(0, 5, -1).ToArray(), []int{}},
+		{"zero step", Range(0, 5, 0).ToArray(), []int{}},
+		{"int8 up to the overflow", Range[int8](120, 127, 5).ToArray(), []int8{120, 125}},
+		{"int8 full range", Range[int8](-128, 127, 1).Len(), 255},
+		{"uint8 stops before overflowing", Range[uint8](250, 255, 10).ToArray(), []uint8{250}},
+		{"floats", Range(0.0, 1.0, 0.25).ToArray(), []float64{0, 0.25, 0.5, 0.75}},
+		{"floats with an inexact step", Range(0.0, 1.0, 0.1).Len(), 10},
+		{"floats counting down", Range(1.0, 0.0, -0.1).Len(), 10},
+		{"float step too small to advance", Range(1e16, 1e17, 1.0).Len(), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("Range returns %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+	for i, v := range Range(0.0, 1.0, 0.1).ToArray() {
+		if want := float64(i) * 0.1; v != want {
+			t.Errorf("Range(0.0, 1.0, 0.1)[%d] is %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	if !equal.Comparable[int]() || !equal.Comparable[string]() {
+		t.Error("int and string must take the map path of Distinct")
+	}
+	if equal.Comparable[account]() {
+		t.Error("account implements Equals and must not take the map path of Distinct")
+	}
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"ints", Of(3, 1, 3, 2, 1).Distinct().ToArray(), []int{3, 1, 2}},
+		{"strings", Of("b", "a", "b", "").Distinct().ToArray(), []string{"b", "a", ""}},
+		{"empty", Empty[int]().Distinct().ToArray(), []int{}},
+		{"custom Equals ignoring a field",
+			Of(account{1, "a"}, account{2, "b"}, account{1, "c"}, account{2, "d"}).Distinct().ToArray(),
+			[]account{{1, "a"}, {2, "b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("Distinct returns %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZeroList(t *testing.T) {
+	var zero List[int]
+	if !zero.IsEmpty() || zero.Len() != 0 || zero.Head().IsPresent() || zero.Last().IsPresent() {
+		t.Errorf("zero List is not empty: %v", zero)
+	}
+	if !zero.Equals(Empty[int]()) || zero.HashCode() != Empty[int]().HashCode() {
+		t.Error("zero List must equal Empty with the same hash code")
+	}
+	if got := zero.Append(1); !got.Equals(Of(1)) {
+		t.Errorf("zero List Append(1) returns %v, want List(1)", got)
+	}
+	if got := zero.String(); got != "List()" {
+		t.Errorf("zero List String returns %q, want %q", got, "List()")
+	}
+	for _, l := range []List[int]{zero, Of[int]()} {
+		data, err := json.Marshal(l)
+		if err != nil || string(data) != "[]" {
+			t.Errorf("json.Marshal(%v) returns %s, %v, want []", l, data, err)
+		}
+	}
+	if got := Map(zero, func(v int) int { return v }); !got.IsEmpty() {
+		t.Errorf("Map(zero List) returns %v, want an empty List", got)
+	}
+}
+
+func TestParMap(t *testing.T) {
+	square := func(n int) int { return n * n }
+	tests := []struct {
+		name        string
+		values      []int
+		parallelism int
+	}{
+		{"default parallelism", []int{1, 2, 3, 4, 5, 6, 7, 8}, 0},
+		{"sequential", []int{1, 2, 3, 4, 5}, 1},
+		{"more goroutines than elements", []int{3, 1, 2}, 8},
+		{"empty list", []int{}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]int, 0, len(tt.values))
+			for _, v := range tt.values {
+				want = append(want, square(v))
+			}
+			got := ParMap(Pure(tt.values), square, ParOptions{Parallelism: tt.parallelism})
+			if !got.IsSuccess() || !reflect.DeepEqual(got.GetOrElse(Empty[int]()).ToArray(), want) {
+				t.Errorf("ParMap(%v) returns %v, want Success(%v)", tt.values, got, want)
+			}
+		})
+	}
+}
+
+func TestParMapCancelledMidRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	got := ParMap(Range(0, 1000, 1), func(n int) int {
+		calls++
+		if n == 2 {
+			cancel()
+		}
+		return n
+	}, ParOptions{Context: ctx, Parallelism: 1})
+	if !got.IsFail() || calls == 1000 {
+		t.Fatalf("ParMap cancelled mid-run returns %v after %d calls, want a failure skipping elements", got, calls)
+	}
+	got.IfFail(func(err error) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("ParMap cancelled mid-run fails with %v, want context.Canceled", err)
+		}
+	})
+}
+
+func TestParMapCancelledAfterLastElement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	got := ParMap(Of(1, 2, 3), func(n int) int {
+		if n == 3 {
+			cancel()
+		}
+		return n * 2
+	}, ParOptions{Context: ctx, Parallelism: 1})
+	if !got.IsSuccess() || !got.GetOrElse(Empty[int]()).Equals(Of(2, 4, 6)) {
+		t.Errorf("ParMap cancelled while processing the last element returns %v, want Success(List(2, 4, 6))", got)
+	}
+}
+
+func TestMapParallelOrdered(t *testing.T) {
+	got := []int{}
+	for r := range MapParallelOrdered(Of(5, 1, 4, 2, 3), func(n int) int {
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return n * n
+	}, ParOptions{Parallelism: 3}) {
+		got = append(got, r)
+	}
+	if want := []int{25, 1, 16, 4, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapParallelOrdered emits %v, want %v", got, want)
+	}
+}
+
+func TestMapParallelOrderedCancelled(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	results := MapParallelOrdered(Range(0, 1000, 1), func(n int) int { return n }, ParOptions{Context: ctx, Parallelism: 4})
+	if first := <-results; first != 0 {
+		t.Fatalf("MapParallelOrdered emits %d first, want 0", first)
+	}
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Errorf("%d goroutines are still running after the cancellation, want %d", n, before)
+	}
+}
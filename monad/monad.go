@@ -1,7 +1,9 @@
 package monad
 
 import (
+	"fmt"
 	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
 )
 
 /*
@@ -95,3 +97,19 @@ func (monad Monad[T]) Equals(other interface{}) bool {
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the Monad consistent with Equals, implementing hash.Hashable.
+Example: Pure(42).HashCode() == Pure(42).HashCode() returns true
+*/
+func (monad Monad[T]) HashCode() uint64 {
+	return hash.Of(monad.value)
+}
+
+/*
+String returns a readable representation of the Monad, implementing fmt.Stringer.
+Example: Pure(42).String() returns "Monad(42)"
+*/
+func (monad Monad[T]) String() string {
+	return fmt.Sprintf("Monad(%v)", monad.value)
+}
@@ -0,0 +1,61 @@
+package monoid
+
+import "github.com/Sugther/go-structs/constraints"
+
+/*
+Monoid is an interface that defines an associative `Combine` operation together with its identity element `Empty`.
+Combining any value with `Empty` returns the value unchanged, so an aggregation can be split into parts
+that are combined independently and merged afterwards without changing the result.
+*/
+type Monoid[T any] interface {
+	Empty() T
+	Combine(T, T) T
+}
+
+type monoid[T any] struct {
+	empty   T
+	combine func(T, T) T
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+func (m monoid[T]) Combine(a T, b T) T {
+	return m.combine(a, b)
+}
+
+/*
+Pure creates a new Monoid from the given identity element and associative combine function.
+Example: Pure(0, func(a int, b int) int { return a + b }) returns the Monoid summing integers.
+*/
+func Pure[T any](empty T, combine func(T, T) T) Monoid[T] {
+	return monoid[T]{
+		empty:   empty,
+		combine: combine,
+	}
+}
+
+/*
+Sum returns the Monoid adding numbers, with 0 as identity.
+Example: Sum[int]().Combine(1, 2) returns 3.
+*/
+func Sum[T constraints.Integer | constraints.Float]() Monoid[T] {
+	return Pure(T(0), func(a T, b T) T { return a + b })
+}
+
+/*
+Product returns the Monoid multiplying numbers, with 1 as identity.
+Example: Product[int]().Combine(2, 3) returns 6.
+*/
+func Product[T constraints.Integer | constraints.Float]() Monoid[T] {
+	return Pure(T(1), func(a T, b T) T { return a * b })
+}
+
+/*
+String returns the Monoid concatenating strings, with "" as identity.
+Example: String().Combine("a", "b") returns "ab".
+*/
+func String() Monoid[string] {
+	return Pure("", func(a string, b string) string { return a + b })
+}
@@ -0,0 +1,296 @@
+package multiset
+
+import (
+	"fmt"
+	"github.com/Sugther/go-structs/list"
+	"strings"
+)
+
+/*
+Multiset is a generic struct representing a collection of comparable values of type T in which a value may appear
+several times. It keeps the multiplicity of each value in a map, so CountOf runs in O(1).
+Like the other containers, a Multiset is immutable: operations returning a Multiset copy it first.
+The iteration order of its elements is unspecified.
+*/
+type Multiset[T comparable] struct {
+	counts map[T]int
+	len    int
+}
+
+/*
+Of creates a new Multiset containing the given values, as many times as they are given.
+Example: Of("a", "b", "a") returns Multiset[string]({a: 2, b: 1})
+*/
+func Of[T comparable](values ...T) Multiset[T] {
+	return Add(Empty[T](), values...)
+}
+
+/*
+Empty creates a new empty Multiset.
+Example: Empty[int]() returns Multiset[int]({})
+*/
+func Empty[T comparable]() Multiset[T] {
+	return Multiset[T]{counts: map[T]int{}}
+}
+
+/*
+FromList creates a new Multiset containing the elements of the given list.
+Example: FromList(list.Of(1, 2, 2)) returns Multiset[int]({1: 1, 2: 2})
+*/
+func FromList[T comparable](l list.List[T]) Multiset[T] {
+	return Of(l.ToArray()...)
+}
+
+/*
+FromMap creates a new Multiset from a map of values to their multiplicities. Values with a count of zero or less are ignored.
+Example: FromMap(map[string]int{"a": 2, "b": 0}) returns Multiset[string]({a: 2})
+*/
+func FromMap[T comparable](counts map[T]int) Multiset[T] {
+	result := Empty[T]()
+	for v, n := range counts {
+		if n > 0 {
+			result.counts[v] = n
+			result.len += n
+		}
+	}
+	return result
+}
+
+/*
+Len returns the number of elements of the multiset, counting each value as many times as it appears.
+Example: Len(Of("a", "b", "a")) returns 3
+*/
+func Len[T comparable](set Multiset[T]) int {
+	return set.len
+}
+
+func (set Multiset[T]) Len() int {
+	return Len(set)
+}
+
+/*
+IsEmpty returns true if the given multiset is empty, false otherwise.
+Examples:
+IsEmpty(Of(1)) returns false
+IsEmpty(Empty[int]()) returns true
+*/
+func IsEmpty[T comparable](set Multiset[T]) bool {
+	return Len(set) == 0
+}
+
+func (set Multiset[T]) IsEmpty() bool {
+	return IsEmpty(set)
+}
+
+/*
+CountOf returns the number of times the given value appears in the multiset, in O(1).
+Examples:
+CountOf(Of("a", "b", "a"), "a") returns 2
+CountOf(Of("a", "b", "a"), "c") returns 0
+*/
+func CountOf[T comparable](set Multiset[T], value T) int {
+	return set.counts[value]
+}
+
+func (set Multiset[T]) CountOf(value T) int {
+	return CountOf(set, value)
+}
+
+/*
+Contains returns true if the given value appears at least once in the multiset.
+Example: Contains(Of(1, 2), 2) returns true
+*/
+func Contains[T comparable](set Multiset[T], value T) bool {
+	return CountOf(set, value) > 0
+}
+
+func (set Multiset[T]) Contains(value T) bool {
+	return Contains(set, value)
+}
+
+/*
+Add returns a new Multiset with the given values added once more each time they are given.
+Example: Add(Of("a"), "a", "b") returns Multiset[string]({a: 2, b: 1})
+*/
+func Add[T comparable](set Multiset[T], values ...T) Multiset[T] {
+	result := copyCounts(set)
+	for _, v := range values {
+		result.counts[v]++
+	}
+	result.len += len(values)
+	return result
+}
+
+func (set Multiset[T]) Add(values ...T) Multiset[T] {
+	return Add(set, values...)
+}
+
+/*
+Remove returns a new Multiset with one occurrence of each of the given values removed, if present.
+Example: Remove(Of("a", "a", "b"), "a", "c") returns Multiset[string]({a: 1, b: 1})
+*/
+func Remove[T comparable](set Multiset[T], values ...T) Multiset[T] {
+	result := copyCounts(set)
+	for _, v := range values {
+		if n := result.counts[v]; n > 0 {
+			setCount(&result, v, n-1)
+		}
+	}
+	return result
+}
+
+func (set Multiset[T]) Remove(values ...T) Multiset[T] {
+	return Remove(set, values...)
+}
+
+/*
+Union returns a new Multiset in which each value appears as many times as in the multiset where it appears the most.
+Example: Union(Of("a", "a", "b"), Of("a", "c")) returns Multiset[string]({a: 2, b: 1, c: 1})
+*/
+func Union[T comparable](set1 Multiset[T], set2 Multiset[T]) Multiset[T] {
+	result := copyCounts(set1)
+	for v, n := range set2.counts {
+		if n > result.counts[v] {
+			setCount(&result, v, n)
+		}
+	}
+	return result
+}
+
+func (set Multiset[T]) Union(set2 Multiset[T]) Multiset[T] {
+	return Union(set, set2)
+}
+
+/*
+Intersection returns a new Multiset in which each value appears as many times as in the multiset where it appears the least.
+Example: Intersection(Of("a", "a", "b"), Of("a", "c")) returns Multiset[string]({a: 1})
+*/
+func Intersection[T comparable](set1 Multiset[T], set2 Multiset[T]) Multiset[T] {
+	result := Empty[T]()
+	for v, n := range set1.counts {
+		if m := set2.counts[v]; m < n {
+			n = m
+		}
+		setCount(&result, v, n)
+	}
+	return result
+}
+
+func (set Multiset[T]) Intersection(set2 Multiset[T]) Multiset[T] {
+	return Intersection(set, set2)
+}
+
+/*
+Sum returns a new Multiset in which each value appears as many times as in both multisets together.
+Example: Sum(Of("a", "a", "b"), Of("a", "c")) returns Multiset[string]({a: 3, b: 1, c: 1})
+*/
+func Sum[T comparable](set1 Multiset[T], set2 Multiset[T]) Multiset[T] {
+	result := copyCounts(set1)
+	for v, n := range set2.counts {
+		setCount(&result, v, result.counts[v]+n)
+	}
+	return result
+}
+
+func (set Multiset[T]) Sum(set2 Multiset[T]) Multiset[T] {
+	return Sum(set, set2)
+}
+
+/*
+Difference returns a new Multiset in which the occurrences of each value in set2 are removed from set1.
+Example: Difference(Of("a", "a", "b"), Of("a", "b", "b")) returns Multiset[string]({a: 1})
+*/
+func Difference[T comparable](set1 Multiset[T], set2 Multiset[T]) Multiset[T] {
+	result := copyCounts(set1)
+	for v, n := range set2.counts {
+		if m := result.counts[v]; m > 0 {
+			if n > m {
+				n = m
+			}
+			setCount(&result, v, m-n)
+		}
+	}
+	return result
+}
+
+func (set Multiset[T]) Difference(set2 Multiset[T]) Multiset[T] {
+	return Difference(set, set2)
+}
+
+/*
+ToList returns the elements of the multiset as a list.List, each value repeated as many times as it appears.
+The values are in an unspecified order, but the occurrences of a value are consecutive.
+Example: ToList(Of("a", "b", "a")) returns List[string](["a","a","b"]) in any order
+*/
+func ToList[T comparable](set Multiset[T]) list.List[T] {
+	values := make([]T, 0, Len(set))
+	for v, n := range set.counts {
+		for i := 0; i < n; i++ {
+			values = append(values, v)
+		}
+	}
+	return list.Pure(values)
+}
+
+func (set Multiset[T]) ToList() list.List[T] {
+	return ToList(set)
+}
+
+/*
+ToMap returns a new map associating each value of the multiset with the number of times it appears.
+Example: ToMap(Of("a", "b", "a")) returns map[string]int{"a": 2, "b": 1}
+*/
+func ToMap[T comparable](set Multiset[T]) map[T]int {
+	return copyCounts(set).counts
+}
+
+func (set Multiset[T]) ToMap() map[T]int {
+	return ToMap(set)
+}
+
+/*
+Equals returns true if other is a Multiset in which every value appears the same number of times.
+Example: Of(1, 2, 1).Equals(Of(1, 1, 2)) returns true.
+*/
+func (set Multiset[T]) Equals(other interface{}) bool {
+	om, ok := other.(Multiset[T])
+	if !ok || Len(set) != Len(om) || len(set.counts) != len(om.counts) {
+		return false
+	}
+	for v, n := range set.counts {
+		if om.counts[v] != n {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+String returns a readable representation of the multiset, implementing fmt.Stringer.
+Example: Of("a", "b", "a").String() returns "Multiset(a: 2, b: 1)" in any order
+*/
+func (set Multiset[T]) String() string {
+	entries := make([]string, 0, len(set.counts))
+	for v, n := range set.counts {
+		entries = append(entries, fmt.Sprintf("%v: %d", v, n))
+	}
+	return "Multiset(" + strings.Join(entries, ", ") + ")"
+}
+
+func copyCounts[T comparable](set Multiset[T]) Multiset[T] {
+	counts := make(map[T]int, len(set.counts))
+	for v, n := range set.counts {
+		counts[v] = n
+	}
+	return Multiset[T]{counts: counts, len: set.len}
+}
+
+// setCount sets the multiplicity of a value in a Multiset being built, keeping its length up to date.
+func setCount[T comparable](set *Multiset[T], value T, count int) {
+	set.len += count - set.counts[value]
+	if count == 0 {
+		delete(set.counts, value)
+	} else {
+		set.counts[value] = count
+	}
+}
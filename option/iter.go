@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package option
+
+import "iter"
+
+/*
+Iter returns an iterator over the value of the Option: it yields the value once if it is present, and nothing otherwise.
+Examples:
+for v := range Iter(Pure(1)) { fmt.Println(v) } prints 1
+for v := range Iter(Empty[int]()) { fmt.Println(v) } does not print
+*/
+func Iter[T any](opt Option[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ForEach(opt, func(t T) { yield(t) })
+	}
+}
+
+func (opt Option[T]) Iter() iter.Seq[T] {
+	return Iter(opt)
+}
+
+/*
+Seq returns the same iterator as Iter, so that an Option can be ranged over like a Set.
+Example: for v := range Seq(Pure(1)) { fmt.Println(v) } prints 1
+*/
+func Seq[T any](opt Option[T]) iter.Seq[T] {
+	return Iter(opt)
+}
+
+func (opt Option[T]) Seq() iter.Seq[T] {
+	return Seq(opt)
+}
@@ -1,34 +1,43 @@
 package option
 
-import "github.com/Sugther/go-structs/equal"
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
+	"github.com/Sugther/go-structs/internal/strict"
+	"github.com/Sugther/go-structs/tuple"
+	"reflect"
+)
 
 /*
 Option represents an optional value container of type T.
+The zero value is an empty Option, so an Option field left uninitialized in a struct holds no value.
 */
 type Option[T any] struct {
-	value   T
-	isEmpty bool
+	value     T
+	isPresent bool
 }
 
 /*
 Pure creates a new Option containing the given value of type T.
-Example: Pure(42) returns Option(42, false).
+Example: Pure(42) returns Option[int](42).
 */
 func Pure[T any](value T) Option[T] {
 	return Option[T]{
-		value:   value,
-		isEmpty: false,
+		value:     value,
+		isPresent: true,
 	}
 }
 
 /*
 Empty creates an empty Option for the given type T.
-Example: Empty[int]() returns Option{isEmpty: true}.
+Example: Empty[int]() returns Option{isPresent: false}.
 */
 func Empty[T any]() Option[T] {
-	return Option[T]{
-		isEmpty: true,
-	}
+	return Option[T]{}
 }
 
 /*
@@ -36,8 +45,8 @@ Of creates an Option from a pointer to a value of type T.
 If the pointer is not nil, it returns an Option containing the value.
 Otherwise, it returns an empty Option.
 Examples:
-Of(&42) returns Option(42, false)
-Of(nil) returns Option{isEmpty: true}.
+Of(&42) returns Option[int](42)
+Of(nil) returns Option{isPresent: false}.
 */
 func Of[T any](value *T) Option[T] {
 	if value != nil {
@@ -46,12 +55,85 @@ func Of[T any](value *T) Option[T] {
 	return Empty[T]()
 }
 
+/*
+OfNullable creates an Option from a value that may be nil, such as an interface, a pointer, a map, a slice, a channel or a function.
+Unlike a comparison with nil, it also treats an interface holding a typed nil, such as a nil *MyError stored in an error, as nil.
+If the value is nil, it returns an empty Option; otherwise it returns an Option containing the value.
+Examples:
+OfNullable[error](nil) returns Option{isPresent: false}
+OfNullable[error]((*MyError)(nil)) returns Option{isPresent: false}
+OfNullable[error](io.EOF) returns Option[error](io.EOF).
+*/
+func OfNullable[T any](value T) Option[T] {
+	v := reflect.ValueOf(&value).Elem()
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			return Empty[T]()
+		}
+	}
+	return Pure(value)
+}
+
+/*
+OfZero creates an Option from a value whose zero value means it is absent.
+If the value is the zero value of T, it returns an empty Option; otherwise it returns an Option containing the value.
+Examples:
+OfZero("") returns Option{isPresent: false}
+OfZero("go") returns Option[string]("go").
+*/
+func OfZero[T comparable](value T) Option[T] {
+	var zero T
+	return When(value != zero, value)
+}
+
+/*
+When creates an Option containing the given value if the condition is true, and an empty Option otherwise.
+Examples:
+When(true, 42) returns Option[int](42)
+When(false, 42) returns Option{isPresent: false}.
+*/
+func When[T any](condition bool, value T) Option[T] {
+	if condition {
+		return Pure(value)
+	}
+	return Empty[T]()
+}
+
+/*
+WhenF is like When, but computes the value with f only if the condition is true.
+Examples:
+WhenF(user.Admin, loadPermissions) returns Option[Permissions](loadPermissions()) if user.Admin is true
+WhenF(false, loadPermissions) returns Option{isPresent: false} without calling loadPermissions.
+*/
+func WhenF[T any](condition bool, f func() T) Option[T] {
+	if condition {
+		return Pure(f())
+	}
+	return Empty[T]()
+}
+
+/*
+Unless creates an Option containing the given value if the condition is false, and an empty Option otherwise.
+Examples:
+Unless(false, 42) returns Option[int](42)
+Unless(true, 42) returns Option{isPresent: false}.
+*/
+func Unless[T any](condition bool, value T) Option[T] {
+	return When(!condition, value)
+}
+
 /*
 Get retrieves the value of type T stored within the Option.
-It does not check if the Option is empty, so use with caution.
+It does not check if the Option is empty, so use with caution: an empty Option returns the zero value of T,
+or panics when built with the `gostructs_strict` tag.
 Example: opt.Get(Pure(42)) returns 42.
 */
 func Get[T any](opt Option[T]) T {
+	strict.Check(opt.isPresent, "option: Get called on an empty Option")
 	return opt.value
 }
 
@@ -66,7 +148,7 @@ IsEmpty(Empty[int]()) returns true
 IsEmpty(Pure(42)) returns false.
 */
 func IsEmpty[T any](opt Option[T]) bool {
-	return opt.isEmpty
+	return !opt.isPresent
 }
 
 func (opt Option[T]) IsEmpty() bool {
@@ -80,13 +162,37 @@ IsPresent(Empty[int]()) returns false
 IsPresent(Pure(42)) returns true.
 */
 func IsPresent[T any](opt Option[T]) bool {
-	return !opt.isEmpty
+	return opt.isPresent
 }
 
 func (opt Option[T]) IsPresent() bool {
 	return IsPresent(opt)
 }
 
+/*
+IsSome is an alias of IsPresent.
+Example: IsSome(Pure(42)) returns true.
+*/
+func IsSome[T any](opt Option[T]) bool {
+	return IsPresent(opt)
+}
+
+func (opt Option[T]) IsSome() bool {
+	return IsSome(opt)
+}
+
+/*
+IsNone is an alias of IsEmpty.
+Example: IsNone(Empty[int]()) returns true.
+*/
+func IsNone[T any](opt Option[T]) bool {
+	return IsEmpty(opt)
+}
+
+func (opt Option[T]) IsNone() bool {
+	return IsNone(opt)
+}
+
 /*
 GetOrElse retrieves the value within the Option if present,
 or returns the provided default value if the Option is empty.
@@ -102,11 +208,25 @@ func (opt Option[T]) GetOrElse(defaultValue T) T {
 	return GetOrElse(opt, defaultValue)
 }
 
+/*
+GetOrElseF is like GetOrElse, but computes the default value with f only if the Option is empty.
+Examples:
+GetOrElseF(Empty[int](), func() int { return 42 }) returns 42
+GetOrElseF(Pure(1), loadDefault) returns 1 without calling loadDefault.
+*/
+func GetOrElseF[T any](opt Option[T], f func() T) T {
+	return Fold(opt, f, func(t T) T { return t })
+}
+
+func (opt Option[T]) GetOrElseF(f func() T) T {
+	return GetOrElseF(opt, f)
+}
+
 /*
 OrElse returns the Option if it contains a value, or returns the provided default Option if the original Option is empty.
 Examples:
-OrElse(Empty[int](), Pure(42)) returns Option(42, false)
-OrElse(Pure(1), Pure(42)) returns Option(1, false).
+OrElse(Empty[int](), Pure(42)) returns Option[int](42)
+OrElse(Pure(1), Pure(42)) returns Option[int](1).
 */
 func OrElse[T any](opt Option[T], defaultValue Option[T]) Option[T] {
 	return Fold(opt, func() Option[T] {
@@ -120,6 +240,39 @@ func (opt Option[T]) OrElse(defaultValue Option[T]) Option[T] {
 	return OrElse[T](opt, defaultValue)
 }
 
+/*
+OrElseF is like OrElse, but computes the default Option with f only if the original Option is empty.
+Examples:
+OrElseF(Empty[int](), func() Option[int] { return Pure(42) }) returns Option[int](42)
+OrElseF(Pure(1), lookupDefault) returns Option[int](1) without calling lookupDefault.
+*/
+func OrElseF[T any](opt Option[T], f func() Option[T]) Option[T] {
+	if IsPresent(opt) {
+		return opt
+	}
+	return f()
+}
+
+func (opt Option[T]) OrElseF(f func() Option[T]) Option[T] {
+	return OrElseF(opt, f)
+}
+
+/*
+FirstPresent returns the first Option of the given ones that contains a value, or an empty Option if none does.
+It is useful for override chains such as flag, then environment, then file, then default.
+Examples:
+FirstPresent(Empty[int](), Pure(1), Pure(2)) returns Option[int](1)
+FirstPresent(Empty[int](), Empty[int]()) returns Option[int]{isPresent: false}.
+*/
+func FirstPresent[T any](opts ...Option[T]) Option[T] {
+	for _, opt := range opts {
+		if IsPresent(opt) {
+			return opt
+		}
+	}
+	return Empty[T]()
+}
+
 /*
 Fold applies one of two functions depending on the state of the Option.
 If the Option is empty, it applies the fEmpty function.
@@ -129,7 +282,7 @@ Fold(Empty[int](), func() int { return 42 }, func(x int) int { return x * 2 }) r
 Fold(Pure(1), func() int { return 42 }, func(x int) int { return x * 2 }) returns 2.
 */
 func Fold[T any, R any](opt Option[T], fEmpty func() R, fPresent func(T) R) R {
-	if opt.isEmpty {
+	if !opt.isPresent {
 		return fEmpty()
 	}
 	return fPresent(opt.value)
@@ -140,25 +293,87 @@ Map applies a given function f to the value stored in the Option and returns a n
 The function f should accept a value of type T and return a value of type R.
 If the original Option is empty, it returns an empty Option[R].
 Examples:
-Map(Empty[int](), func(x int) int { return x * 2 }) returns Option{isEmpty: true}
-Map(Pure(1), func(x int) int { return x * 2 }) returns Option(2, false).
+Map(Empty[int](), func(x int) int { return x * 2 }) returns Option{isPresent: false}
+Map(Pure(1), func(x int) int { return x * 2 }) returns Option[int](2).
 */
 func Map[T any, R any](opt Option[T], f func(T) R) Option[R] {
 	return Fold(opt, Empty[R], func(t T) Option[R] { return Pure(f(t)) })
 }
 
+/*
+Ap applies the function contained in the first Option to the value contained in the second one.
+If either Option is empty, it returns an empty Option.
+Examples:
+Ap(Pure(func(x int) int { return x * 2 }), Pure(21)) returns Option[int](42)
+Ap(Empty[func(int) int](), Pure(21)) returns Option{isPresent: false}
+*/
+func Ap[T any, R any](f Option[func(T) R], opt Option[T]) Option[R] {
+	return FlatMap(f, func(g func(T) R) Option[R] {
+		return Map(opt, g)
+	})
+}
+
 /*
 FlatMap applies a given function f to the value stored in the Option and returns a new Option of type R.
 The function f should accept a value of type T and return an Option[R].
 If the original Option is empty, it returns an empty Option[R].
 Examples:
-FlatMap(Empty[int](), func(x int) Option[int] { return Pure(x * 2) }) returns Option{isEmpty: true}
-FlatMap(Pure(1), func(x int) Option[int] { return Pure(x * 2) }) returns Option(2, false).
+FlatMap(Empty[int](), func(x int) Option[int] { return Pure(x * 2) }) returns Option{isPresent: false}
+FlatMap(Pure(1), func(x int) Option[int] { return Pure(x * 2) }) returns Option[int](2).
 */
 func FlatMap[T any, R any](opt Option[T], f func(T) Option[R]) Option[R] {
 	return Fold(opt, Empty[R], f)
 }
 
+/*
+Flatten collapses an Option of Option into a single Option, empty if either level is empty.
+Examples:
+Flatten(Pure(Pure(1))) returns Option[int](1)
+Flatten(Pure(Empty[int]())) returns Option{isPresent: false}
+*/
+func Flatten[T any](opt Option[Option[T]]) Option[T] {
+	return FlatMap(opt, func(t Option[T]) Option[T] { return t })
+}
+
+/*
+Zip combines the values of two Options into an Option of a Tuple.
+If either Option is empty, it returns an empty Option.
+Examples:
+Zip(Pure(1), Pure("a")) returns Option[tuple.Tuple[int, string]](Tuple(1, "a"))
+Zip(Pure(1), Empty[string]()) returns Option{isPresent: false}
+*/
+func Zip[A any, B any](a Option[A], b Option[B]) Option[tuple.Tuple[A, B]] {
+	return Map2(a, b, tuple.Pure[A, B])
+}
+
+/*
+Map2 applies a function to the values of two Options and returns the result in a new Option.
+If any Option is empty, the function is not called and it returns an empty Option.
+Examples:
+Map2(Pure(1), Pure(2), func(a int, b int) int { return a + b }) returns Option[int](3)
+Map2(Pure(1), Empty[int](), func(a int, b int) int { return a + b }) returns Option{isPresent: false}
+*/
+func Map2[A any, B any, R any](a Option[A], b Option[B], f func(A, B) R) Option[R] {
+	if IsEmpty(a) || IsEmpty(b) {
+		return Empty[R]()
+	}
+	return Pure(f(a.value, b.value))
+}
+
+/*
+Map3 applies a function to the values of three Options and returns the result in a new Option.
+If any Option is empty, the function is not called and it returns an empty Option.
+Examples:
+Map3(Pure(1), Pure(2), Pure(3), func(a int, b int, c int) int { return a + b + c }) returns Option[int](6)
+Map3(Pure(1), Empty[int](), Pure(3), func(a int, b int, c int) int { return a + b + c }) returns Option{isPresent: false}
+*/
+func Map3[A any, B any, C any, R any](a Option[A], b Option[B], c Option[C], f func(A, B, C) R) Option[R] {
+	if IsEmpty(a) || IsEmpty(b) || IsEmpty(c) {
+		return Empty[R]()
+	}
+	return Pure(f(a.value, b.value, c.value))
+}
+
 /*
 ForEach applies a given function f to the value stored in the Option for its side effects.
 If the Option is empty, the function is not called.
@@ -203,7 +418,7 @@ IfEmpty(Empty[int](), func() { fmt.Println("empty") }) prints "empty"
 IfEmpty(Pure(1), func() { fmt.Println("empty") }) does not print.
 */
 func IfEmpty[T any](opt Option[T], f func()) {
-	if opt.isEmpty {
+	if !opt.isPresent {
 		f()
 	}
 }
@@ -218,9 +433,9 @@ returns a new Option. If the predicate function returns true, the new Option
 contains the same value as the original. If the predicate returns false or the
 original Option is empty, the new Option is empty.
 Examples:
-Filter(Empty[int](), func(x int) bool { return x > 0 }) returns Option{isEmpty: true}
-Filter(Pure(1), func(x int) bool { return x > 0 }) returns Option(1, false)
-Filter(Pure(-1), func(x int) bool { return x > 0 }) returns Option{isEmpty: true}.
+Filter(Empty[int](), func(x int) bool { return x > 0 }) returns Option{isPresent: false}
+Filter(Pure(1), func(x int) bool { return x > 0 }) returns Option[int](1)
+Filter(Pure(-1), func(x int) bool { return x > 0 }) returns Option{isPresent: false}.
 */
 func Filter[T any](opt Option[T], f func(T) bool) Option[T] {
 	return FlatMap(opt, func(value T) Option[T] {
@@ -235,6 +450,35 @@ func (opt Option[T]) Filter(predicate func(T) bool) Option[T] {
 	return Filter(opt, predicate)
 }
 
+/*
+ToSlice returns a slice holding the value of the Option if it is present, and an empty slice otherwise.
+Examples:
+ToSlice(Pure(1)) returns []int{1}
+ToSlice(Empty[int]()) returns []int{}
+*/
+func ToSlice[T any](opt Option[T]) []T {
+	return Fold(opt, func() []T { return []T{} }, func(t T) []T { return []T{t} })
+}
+
+func (opt Option[T]) ToSlice() []T {
+	return ToSlice(opt)
+}
+
+/*
+ToPointer returns a pointer to a copy of the value of the Option if it is present, and nil otherwise.
+It is the inverse of Of, for APIs modelling optional values with pointers.
+Examples:
+*ToPointer(Pure(42)) returns 42
+ToPointer(Empty[int]()) returns nil
+*/
+func ToPointer[T any](opt Option[T]) *T {
+	return Fold(opt, func() *T { return nil }, func(t T) *T { return &t })
+}
+
+func (opt Option[T]) ToPointer() *T {
+	return ToPointer(opt)
+}
+
 /*
 Contains checks if the Option contains a specific value.
 Returns true if the Option contains the given value, false otherwise.
@@ -245,16 +489,155 @@ Contains(Pure(42), 21) returns false
 Contains(Empty[int](), 42) returns false
 */
 func Contains[T any](opt Option[T], value T) bool {
-	return !opt.isEmpty && equal.Equals(opt.value, value)
+	return opt.isPresent && equal.Equals(opt.value, value)
 }
 
 func (opt Option[T]) Contains(value T) bool {
 	return Contains(opt, value)
 }
 
+/*
+Exists returns true if the Option contains a value satisfying the predicate, false otherwise.
+Examples:
+Exists(Pure(42), func(x int) bool { return x > 0 }) returns true
+Exists(Pure(-1), func(x int) bool { return x > 0 }) returns false
+Exists(Empty[int](), func(x int) bool { return x > 0 }) returns false
+*/
+func Exists[T any](opt Option[T], predicate func(T) bool) bool {
+	return Fold(opt, func() bool { return false }, predicate)
+}
+
+func (opt Option[T]) Exists(predicate func(T) bool) bool {
+	return Exists(opt, predicate)
+}
+
+/*
+ForAll returns true if the Option is empty or contains a value satisfying the predicate, false otherwise.
+Examples:
+ForAll(Pure(42), func(x int) bool { return x > 0 }) returns true
+ForAll(Pure(-1), func(x int) bool { return x > 0 }) returns false
+ForAll(Empty[int](), func(x int) bool { return x > 0 }) returns true
+*/
+func ForAll[T any](opt Option[T], predicate func(T) bool) bool {
+	return Fold(opt, func() bool { return true }, predicate)
+}
+
+func (opt Option[T]) ForAll(predicate func(T) bool) bool {
+	return ForAll(opt, predicate)
+}
+
+/*
+Equals returns true if other is an Option of the same type that is either empty like this one,
+or present with a value equal to this one's according to equal.Equals.
+Examples:
+Pure(42).Equals(Pure(42)) returns true
+Pure(42).Equals(Empty[int]()) returns false
+*/
 func (opt Option[T]) Equals(other interface{}) bool {
-	if oo, ok := other.(Option[T]); ok {
-		return (oo.isEmpty && opt.isEmpty) || equal.Equals(oo.value, oo.value)
+	oo, ok := other.(Option[T])
+	if !ok || opt.isPresent != oo.isPresent {
+		return false
+	}
+	return !opt.isPresent || equal.Equals(opt.value, oo.value)
+}
+
+/*
+HashCode returns a hash code of the Option consistent with Equals, implementing hash.Hashable.
+Example: Pure(42).HashCode() == Pure(42).HashCode() returns true
+*/
+func (opt Option[T]) HashCode() uint64 {
+	if !opt.isPresent {
+		return 0
+	}
+	return hash.Combine(hash.Of(opt.value))
+}
+
+/*
+String returns a readable representation of the Option, implementing fmt.Stringer.
+Examples:
+Pure(42).String() returns "Some(42)"
+Empty[int]().String() returns "None"
+*/
+func (opt Option[T]) String() string {
+	return Fold(opt, func() string {
+		return "None"
+	}, func(t T) string {
+		return fmt.Sprintf("Some(%v)", t)
+	})
+}
+
+/*
+MarshalJSON encodes the Option as its value if it is present, and as null otherwise, implementing json.Marshaler.
+Examples:
+json.Marshal(Pure(42)) returns []byte("42")
+json.Marshal(Empty[int]()) returns []byte("null")
+*/
+func (opt Option[T]) MarshalJSON() ([]byte, error) {
+	if !IsPresent(opt) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(opt.value)
+}
+
+/*
+UnmarshalJSON decodes a JSON value into opt, implementing json.Unmarshaler.
+null decodes to an empty Option and any other value to a present one, so an Option can replace a pointer field.
+A present Option holding a value encoded as null, such as a nil pointer, therefore decodes to an empty Option.
+A field absent from the JSON object is left untouched, so it stays an empty Option if it was not initialized.
+Examples:
+json.Unmarshal([]byte("42"), &opt) sets opt to Pure(42)
+json.Unmarshal([]byte("null"), &opt) sets opt to Empty[int]()
+*/
+func (opt *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*opt = Empty[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*opt = Pure(value)
+	return nil
+}
+
+/*
+GobEncode encodes the Option with encoding/gob, implementing gob.GobEncoder.
+The value is only encoded when the Option contains one.
+Example: gob.NewEncoder(w).Encode(Pure(42)) writes the Option to w
+*/
+func (opt Option[T]) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := gob.NewEncoder(&buffer)
+	if err := encoder.Encode(IsPresent(opt)); err != nil {
+		return nil, err
+	}
+	if IsPresent(opt) {
+		if err := encoder.Encode(opt.value); err != nil {
+			return nil, err
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+/*
+GobDecode decodes an Option encoded by GobEncode into opt, implementing gob.GobDecoder.
+Example: gob.NewDecoder(r).Decode(&opt) reads an Option from r into opt
+*/
+func (opt *Option[T]) GobDecode(data []byte) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+	var present bool
+	if err := decoder.Decode(&present); err != nil {
+		return err
+	}
+	if !present {
+		*opt = Empty[T]()
+		return nil
+	}
+	var value T
+	if err := decoder.Decode(&value); err != nil {
+		return err
 	}
-	return false
+	*opt = Pure(value)
+	return nil
 }
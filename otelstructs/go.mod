@@ -0,0 +1,11 @@
+module github.com/Sugther/go-structs/otelstructs
+
+go 1.25.0
+
+require (
+	github.com/Sugther/go-structs v0.0.0-20261016084211-8bd7dbb31e68
+	go.opentelemetry.io/otel v1.46.0
+	go.opentelemetry.io/otel/trace v1.46.0
+)
+
+require github.com/cespare/xxhash/v2 v2.3.0 // indirect
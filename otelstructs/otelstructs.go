@@ -0,0 +1,73 @@
+/*
+Package otelstructs traces Try pipelines and parallel list stages with OpenTelemetry.
+Each stage runs inside its own span, named by the caller, and a failed Try records its error on the span
+and sets the span status to Error, so monadic pipelines show up in distributed traces without manual span plumbing.
+Attributes and other span settings are passed as trace.SpanStartOption values, for example trace.WithAttributes.
+*/
+package otelstructs
+
+import (
+	"context"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/*
+Try runs f inside a span named name and returns its result.
+The context given to f carries the span, so nested stages become its children.
+Example:
+Try(ctx, tracer, "load user", func(ctx context.Context) try.Try[User] { return try.Pure(repo.Load(ctx, id)) })
+*/
+func Try[T any](ctx context.Context, tracer trace.Tracer, name string, f func(context.Context) try.Try[T], opts ...trace.SpanStartOption) try.Try[T] {
+	ctx, span := tracer.Start(ctx, name, opts...)
+	defer span.End()
+	result := f(ctx)
+	result.IfFail(func(err error) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	})
+	return result
+}
+
+/*
+FlatMap applies f to the successful computation result of t inside a span named name.
+If t contains a failed computation, f is not called and no span is started.
+Example:
+FlatMap(ctx, tracer, "parse", raw, func(ctx context.Context, s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) })
+*/
+func FlatMap[T any, R any](ctx context.Context, tracer trace.Tracer, name string, t try.Try[T], f func(context.Context, T) try.Try[R], opts ...trace.SpanStartOption) try.Try[R] {
+	return try.FlatMap(t, func(value T) try.Try[R] {
+		return Try(ctx, tracer, name, func(ctx context.Context) try.Try[R] {
+			return f(ctx, value)
+		}, opts...)
+	})
+}
+
+/*
+Map applies f to the successful computation result of t inside a span named name.
+If t contains a failed computation, f is not called and no span is started.
+Example:
+Map(ctx, tracer, "double", try.Success(21), func(ctx context.Context, n int) int { return n * 2 }) returns Success[int](42)
+*/
+func Map[T any, R any](ctx context.Context, tracer trace.Tracer, name string, t try.Try[T], f func(context.Context, T) R, opts ...trace.SpanStartOption) try.Try[R] {
+	return FlatMap(ctx, tracer, name, t, func(ctx context.Context, value T) try.Try[R] {
+		return try.Success(f(ctx, value))
+	}, opts...)
+}
+
+/*
+ParMap runs list.ParMap over l inside a span named name, so that a parallel stage shows up as a single span.
+The context given to f carries the span, and it replaces opts.Context so that the stage stops when ctx is cancelled.
+Example:
+ParMap(ctx, tracer, "resize", images, func(ctx context.Context, img Image) Image { return resize(ctx, img) }, list.ParOptions{Parallelism: 4})
+*/
+func ParMap[T any, R any](ctx context.Context, tracer trace.Tracer, name string, l list.List[T], f func(context.Context, T) R, opts list.ParOptions, spanOpts ...trace.SpanStartOption) try.Try[list.List[R]] {
+	return Try(ctx, tracer, name, func(ctx context.Context) try.Try[list.List[R]] {
+		opts.Context = ctx
+		return list.ParMap(l, func(value T) R {
+			return f(ctx, value)
+		}, opts)
+	}, spanOpts...)
+}
@@ -0,0 +1,92 @@
+package otelstructs
+
+import (
+	"context"
+	"errors"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// spanKey marks the contexts returned by recorder.Start with the name of their span.
+type spanKey struct{}
+
+// recorder is a Tracer recording the spans it starts.
+type recorder struct {
+	noop.Tracer
+	mutex sync.Mutex
+	spans []*span
+}
+
+type span struct {
+	noop.Span
+	name   string
+	err    error
+	status codes.Code
+	ended  bool
+}
+
+func (s *span) RecordError(err error, _ ...trace.EventOption) { s.err = err }
+func (s *span) SetStatus(code codes.Code, _ string)           { s.status = code }
+func (s *span) End(...trace.SpanEndOption)                    { s.ended = true }
+
+func (r *recorder) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s := &span{name: name}
+	r.spans = append(r.spans, s)
+	return context.WithValue(ctx, spanKey{}, name), s
+}
+
+func TestTryRecordsFailure(t *testing.T) {
+	tracer := &recorder{}
+	errFailed := errors.New("failed")
+	result := Try(context.Background(), tracer, "load", func(context.Context) try.Try[int] {
+		return try.Fail[int](errFailed)
+	})
+	if !result.IsFail() {
+		t.Fatalf("Try returns %v, want a failure", result)
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Try started %d spans, want 1", len(tracer.spans))
+	}
+	if s := tracer.spans[0]; s.name != "load" || s.err != errFailed || s.status != codes.Error || !s.ended {
+		t.Errorf("span %q: error %v, status %v, ended %v", s.name, s.err, s.status, s.ended)
+	}
+}
+
+func TestParMap(t *testing.T) {
+	tracer := &recorder{}
+	result := ParMap(context.Background(), tracer, "square", list.Of(1, 2, 3), func(ctx context.Context, n int) int {
+		if ctx.Value(spanKey{}) != "square" {
+			t.Errorf("f is called with a context not carrying the stage span")
+		}
+		return n * n
+	}, list.ParOptions{Parallelism: 2})
+	if got := result.GetOrElse(list.Empty[int]()).ToArray(); !reflect.DeepEqual(got, []int{1, 4, 9}) {
+		t.Errorf("ParMap returns %v, want [1 4 9]", result)
+	}
+	if len(tracer.spans) != 1 || tracer.spans[0].name != "square" || !tracer.spans[0].ended {
+		t.Errorf("ParMap must run inside a single ended span named square, got %d spans", len(tracer.spans))
+	}
+}
+
+func TestParMapCancelled(t *testing.T) {
+	tracer := &recorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := ParMap(ctx, tracer, "square", list.Range(0, 100, 1), func(_ context.Context, n int) int {
+		return n * n
+	}, list.ParOptions{Context: context.Background(), Parallelism: 1})
+	if !result.IsFail() {
+		t.Fatalf("ParMap with a cancelled context returns %v, want a failure", result)
+	}
+	if s := tracer.spans[0]; !errors.Is(s.err, context.Canceled) || s.status != codes.Error {
+		t.Errorf("span error %v, status %v, want context.Canceled and Error", s.err, s.status)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package persistent
+
+import "iter"
+
+/*
+Iter returns an iterator over the elements of the list, in index order.
+Example: for v := range Iter(Of(1, 2, 3)) { fmt.Println(v) } prints 1, 2 and 3
+*/
+func Iter[T any](l List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		each(l.root, yield)
+	}
+}
+
+func (l List[T]) Iter() iter.Seq[T] {
+	return Iter(l)
+}
+
+// each calls yield on the values of the tree in order, and returns false as soon as yield does.
+func each[T any](n *node[T], yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return each(n.left, yield) && yield(n.value) && each(n.right, yield)
+}
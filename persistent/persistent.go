@@ -0,0 +1,329 @@
+package persistent
+
+import (
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+)
+
+/*
+List is a generic immutable indexed sequence of values of type T backed by a height-balanced binary tree.
+Every update returns a new List sharing all untouched nodes with the original one, so Get, Update, Insert,
+Prepend, Append and Remove run in O(log n) time and allocate only O(log n) new nodes.
+The zero value is an empty List.
+*/
+type List[T any] struct {
+	root *node[T]
+}
+
+type node[T any] struct {
+	value  T
+	left   *node[T]
+	right  *node[T]
+	size   int
+	height int
+}
+
+/*
+Empty creates a new empty List.
+Example: Empty[int]() returns List[int]([])
+*/
+func Empty[T any]() List[T] {
+	return List[T]{}
+}
+
+/*
+Of creates a new List containing the given values.
+Example: Of(1, 2, 3) returns List[int]([1,2,3])
+*/
+func Of[T any](values ...T) List[T] {
+	return List[T]{root: build(values)}
+}
+
+/*
+FromList creates a new List containing the elements of the given list.List.
+Example: FromList(list.Of(1, 2, 3)) returns List[int]([1,2,3])
+*/
+func FromList[T any](l list.List[T]) List[T] {
+	return Of(l.ToArray()...)
+}
+
+/*
+Len returns the length of the given list.
+Example: Len(Of(1, 2, 3)) returns 3
+*/
+func Len[T any](l List[T]) int {
+	return size(l.root)
+}
+
+func (l List[T]) Len() int {
+	return Len(l)
+}
+
+/*
+IsEmpty returns true if the given list is empty, false otherwise.
+Examples:
+IsEmpty(Of(1, 2, 3)) returns false
+IsEmpty(Empty[int]()) returns true
+*/
+func IsEmpty[T any](l List[T]) bool {
+	return l.root == nil
+}
+
+func (l List[T]) IsEmpty() bool {
+	return IsEmpty(l)
+}
+
+/*
+Get returns the element at the given index wrapped in an Option.
+If the index is out of range, it returns an empty Option.
+Examples:
+Get(Of(1, 2, 3), 1) returns Option[int](2)
+Get(Of(1, 2, 3), 3) returns Option[int]{isPresent: false}
+*/
+func Get[T any](l List[T], index int) option.Option[T] {
+	if index < 0 || index >= Len(l) {
+		return option.Empty[T]()
+	}
+	n := l.root
+	for {
+		leftSize := size(n.left)
+		switch {
+		case index < leftSize:
+			n = n.left
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.right
+		default:
+			return option.Pure(n.value)
+		}
+	}
+}
+
+func (l List[T]) Get(index int) option.Option[T] {
+	return Get(l, index)
+}
+
+/*
+Update returns a new List with the element at the given index replaced by the result of f applied to it.
+If the index is out of range, the list is returned unchanged.
+Examples:
+Update(Of(1, 2, 3), 1, func(n int) int { return n * 10 }) returns List[int]([1,20,3])
+Update(Of(1, 2, 3), 5, func(n int) int { return n * 10 }) returns List[int]([1,2,3])
+*/
+func Update[T any](l List[T], index int, f func(T) T) List[T] {
+	if index < 0 || index >= Len(l) {
+		return l
+	}
+	return List[T]{root: update(l.root, index, f)}
+}
+
+func (l List[T]) Update(index int, f func(T) T) List[T] {
+	return Update(l, index, f)
+}
+
+/*
+Insert returns a new List with the given value inserted at the given index, shifting the following elements.
+An index equal to the length of the list appends the value. If the index is out of range, the list is returned unchanged.
+Examples:
+Insert(Of(1, 2, 3), 1, 9) returns List[int]([1,9,2,3])
+Insert(Of(1, 2, 3), 5, 9) returns List[int]([1,2,3])
+*/
+func Insert[T any](l List[T], index int, value T) List[T] {
+	if index < 0 || index > Len(l) {
+		return l
+	}
+	return List[T]{root: insert(l.root, index, value)}
+}
+
+func (l List[T]) Insert(index int, value T) List[T] {
+	return Insert(l, index, value)
+}
+
+/*
+Prepend returns a new List with the given value added before the first element.
+Example: Prepend(Of(1, 2, 3), 0) returns List[int]([0,1,2,3])
+*/
+func Prepend[T any](l List[T], value T) List[T] {
+	return Insert(l, 0, value)
+}
+
+func (l List[T]) Prepend(value T) List[T] {
+	return Prepend(l, value)
+}
+
+/*
+Append returns a new List with the given values added after the last element.
+Example: Append(Of(1, 2, 3), 4, 5) returns List[int]([1,2,3,4,5])
+*/
+func Append[T any](l List[T], values ...T) List[T] {
+	for _, v := range values {
+		l = Insert(l, Len(l), v)
+	}
+	return l
+}
+
+func (l List[T]) Append(values ...T) List[T] {
+	return Append(l, values...)
+}
+
+/*
+Remove returns a new List without the element at the given index.
+If the index is out of range, the list is returned unchanged.
+Examples:
+Remove(Of(1, 2, 3), 1) returns List[int]([1,3])
+Remove(Of(1, 2, 3), 5) returns List[int]([1,2,3])
+*/
+func Remove[T any](l List[T], index int) List[T] {
+	if index < 0 || index >= Len(l) {
+		return l
+	}
+	return List[T]{root: remove(l.root, index)}
+}
+
+func (l List[T]) Remove(index int) List[T] {
+	return Remove(l, index)
+}
+
+/*
+Fold applies a function to the elements of the list in order in a cumulative way, starting from the given root value.
+Examples:
+Fold(Of(1, 2, 3, 4), 0, func(a int, b int) int { return a + b }) returns 10
+Fold(Empty[int](), 10, func(a int, b int) int { return a + b }) returns 10
+*/
+func Fold[T any, R any](l List[T], root R, f func(R, T) R) R {
+	return fold(l.root, root, f)
+}
+
+/*
+ToArray returns the elements of the list as a new slice.
+Example: ToArray(Of(1, 2, 3)) returns []int{1, 2, 3}
+*/
+func ToArray[T any](l List[T]) []T {
+	return Fold(l, make([]T, 0, Len(l)), func(values []T, v T) []T {
+		return append(values, v)
+	})
+}
+
+func (l List[T]) ToArray() []T {
+	return ToArray(l)
+}
+
+/*
+ToList returns the elements of the list as a list.List.
+Example: ToList(Of(1, 2, 3)) returns list.List[int]([1,2,3])
+*/
+func ToList[T any](l List[T]) list.List[T] {
+	return list.Pure(ToArray(l))
+}
+
+func (l List[T]) ToList() list.List[T] {
+	return ToList(l)
+}
+
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func height[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func newNode[T any](left *node[T], value T, right *node[T]) *node[T] {
+	h := height(left)
+	if hr := height(right); hr > h {
+		h = hr
+	}
+	return &node[T]{
+		value:  value,
+		left:   left,
+		right:  right,
+		size:   size(left) + size(right) + 1,
+		height: h + 1,
+	}
+}
+
+func build[T any](values []T) *node[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	return newNode(build(values[:mid]), values[mid], build(values[mid+1:]))
+}
+
+// balance creates a node from subtrees whose heights differ by at most two, rotating it back into AVL shape.
+func balance[T any](left *node[T], value T, right *node[T]) *node[T] {
+	switch {
+	case height(left) > height(right)+1:
+		if height(left.left) >= height(left.right) {
+			return newNode(left.left, left.value, newNode(left.right, value, right))
+		}
+		lr := left.right
+		return newNode(newNode(left.left, left.value, lr.left), lr.value, newNode(lr.right, value, right))
+	case height(right) > height(left)+1:
+		if height(right.right) >= height(right.left) {
+			return newNode(newNode(left, value, right.left), right.value, right.right)
+		}
+		rl := right.left
+		return newNode(newNode(left, value, rl.left), rl.value, newNode(rl.right, right.value, right.right))
+	default:
+		return newNode(left, value, right)
+	}
+}
+
+func update[T any](n *node[T], index int, f func(T) T) *node[T] {
+	leftSize := size(n.left)
+	switch {
+	case index < leftSize:
+		return newNode(update(n.left, index, f), n.value, n.right)
+	case index > leftSize:
+		return newNode(n.left, n.value, update(n.right, index-leftSize-1, f))
+	default:
+		return newNode(n.left, f(n.value), n.right)
+	}
+}
+
+func insert[T any](n *node[T], index int, value T) *node[T] {
+	if n == nil {
+		return newNode(nil, value, nil)
+	}
+	leftSize := size(n.left)
+	if index <= leftSize {
+		return balance(insert(n.left, index, value), n.value, n.right)
+	}
+	return balance(n.left, n.value, insert(n.right, index-leftSize-1, value))
+}
+
+func remove[T any](n *node[T], index int) *node[T] {
+	leftSize := size(n.left)
+	switch {
+	case index < leftSize:
+		return balance(remove(n.left, index), n.value, n.right)
+	case index > leftSize:
+		return balance(n.left, n.value, remove(n.right, index-leftSize-1))
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	default:
+		first := n.right
+		for first.left != nil {
+			first = first.left
+		}
+		return balance(n.left, first.value, remove(n.right, 0))
+	}
+}
+
+func fold[T any, R any](n *node[T], acc R, f func(R, T) R) R {
+	if n == nil {
+		return acc
+	}
+	acc = fold(n.left, acc, f)
+	acc = f(acc, n.value)
+	return fold(n.right, acc, f)
+}
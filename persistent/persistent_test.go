@@ -0,0 +1,151 @@
+package persistent
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkInvariants fails the test if a node has a wrong size or height, or if its subtrees are not AVL balanced.
+func checkInvariants[T any](t *testing.T, n *node[T]) {
+	t.Helper()
+	var walk func(n *node[T]) (int, int)
+	walk = func(n *node[T]) (int, int) {
+		if n == nil {
+			return 0, 0
+		}
+		leftSize, leftHeight := walk(n.left)
+		rightSize, rightHeight := walk(n.right)
+		if n.size != leftSize+rightSize+1 {
+			t.Fatalf("node %v has size %d, want %d", n.value, n.size, leftSize+rightSize+1)
+		}
+		h := leftHeight
+		if rightHeight > h {
+			h = rightHeight
+		}
+		if n.height != h+1 {
+			t.Fatalf("node %v has height %d, want %d", n.value, n.height, h+1)
+		}
+		if leftHeight-rightHeight > 1 || rightHeight-leftHeight > 1 {
+			t.Fatalf("node %v is unbalanced: left height %d, right height %d", n.value, leftHeight, rightHeight)
+		}
+		return n.size, n.height
+	}
+	walk(n)
+}
+
+// apply runs the operation encoded by op and arg on both the List and the slice it is compared to.
+func apply(l List[int], values []int, op byte, arg int, value int) (List[int], []int) {
+	switch op % 5 {
+	case 0:
+		index := arg % (len(values) + 1)
+		values = append(values[:index:index], append([]int{value}, values[index:]...)...)
+		return l.Insert(index, value), values
+	case 1:
+		if len(values) == 0 {
+			return l.Remove(0), values
+		}
+		index := arg % len(values)
+		values = append(values[:index:index], values[index+1:]...)
+		return l.Remove(index), values
+	case 2:
+		if len(values) == 0 {
+			return l.Update(0, func(n int) int { return n + value }), values
+		}
+		index := arg % len(values)
+		values = append([]int{}, values...)
+		values[index] += value
+		return l.Update(index, func(n int) int { return n + value }), values
+	case 3:
+		values = append(values[:len(values):len(values)], value, value+1)
+		return l.Append(value, value+1), values
+	default:
+		values = append([]int{value}, values...)
+		return l.Prepend(value), values
+	}
+}
+
+// checkOperations applies the operations encoded by ops to an empty List and to a slice, checking them after each step.
+func checkOperations(t *testing.T, ops []byte) {
+	t.Helper()
+	l, values := Empty[int](), []int{}
+	var versions []List[int]
+	var snapshots [][]int
+	for i := 0; i+1 < len(ops); i += 2 {
+		versions, snapshots = append(versions, l), append(snapshots, values)
+		l, values = apply(l, values, ops[i], int(ops[i+1]), i)
+		checkInvariants(t, l.root)
+		if got := l.ToArray(); !reflect.DeepEqual(got, values) {
+			t.Fatalf("after operation %d (%d on %d): %v, want %v", i/2, ops[i]%5, ops[i+1], got, values)
+		}
+		if l.Len() != len(values) {
+			t.Fatalf("after operation %d: Len is %d, want %d", i/2, l.Len(), len(values))
+		}
+	}
+	for i, version := range versions {
+		if got := version.ToArray(); !reflect.DeepEqual(got, snapshots[i]) {
+			t.Fatalf("version %d changed to %v, want %v", i, got, snapshots[i])
+		}
+	}
+}
+
+func FuzzOperations(f *testing.F) {
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 1, 1})
+	f.Add([]byte{3, 0, 3, 0, 3, 0, 1, 2, 1, 0, 2, 3})
+	f.Add([]byte{4, 0, 4, 0, 4, 0, 4, 0, 1, 5, 1, 5})
+	f.Fuzz(checkOperations)
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 50; run++ {
+		ops := make([]byte, 2_000)
+		r.Read(ops)
+		checkOperations(t, ops)
+	}
+}
+
+func TestSequentialInsertsStayBalanced(t *testing.T) {
+	tests := []struct {
+		name  string
+		index func(l List[int]) int
+	}{
+		{"append", func(l List[int]) int { return l.Len() }},
+		{"prepend", func(l List[int]) int { return 0 }},
+		{"middle", func(l List[int]) int { return l.Len() / 2 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := Empty[int]()
+			for i := 0; i < 1_000; i++ {
+				l = l.Insert(tt.index(l), i)
+			}
+			checkInvariants(t, l.root)
+			// An AVL tree of n nodes is at most about 1.44 log2(n) high.
+			if h := height(l.root); h > 14 {
+				t.Errorf("height of a tree of 1000 nodes is %d, want at most 14", h)
+			}
+			for l.Len() > 0 {
+				l = l.Remove(l.Len() / 3)
+				checkInvariants(t, l.root)
+			}
+		})
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	l := Of(1, 2, 3)
+	if got := l.Get(3); got.IsPresent() {
+		t.Errorf("Get(3) returns %v, want an empty Option", got)
+	}
+	for name, got := range map[string]List[int]{
+		"Insert after the end":    l.Insert(4, 0),
+		"Remove":                  l.Remove(-1),
+		"Update":                  l.Update(3, func(n int) int { return n + 1 }),
+		"Insert before the first": l.Insert(-1, 0),
+	} {
+		if !reflect.DeepEqual(got.ToArray(), []int{1, 2, 3}) {
+			t.Errorf("%s out of range returns %v, want [1 2 3]", name, got.ToArray())
+		}
+	}
+}
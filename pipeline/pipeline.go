@@ -0,0 +1,146 @@
+package pipeline
+
+import (
+	"fmt"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+)
+
+/*
+Stage is a named step of a pipeline turning the elements of a List[T] into the elements of a List[R].
+Stages are created with Map, Filter and Traverse and chained with Then.
+Each element keeps the index it had in the list given to Run, so a failure can be traced back to its input.
+*/
+type Stage[T any, R any] struct {
+	name string
+	run  func([]indexed[T]) ([]indexed[R], error)
+}
+
+type indexed[T any] struct {
+	index int
+	value T
+}
+
+/*
+StageError is the error returned by Run when a stage fails.
+It carries the name of the failing stage and the index of the failing element in the list given to Run,
+and wraps the error returned by the stage, so errors.Is and errors.As see through it.
+*/
+type StageError struct {
+	Stage string
+	Index int
+	Err   error
+}
+
+func (err *StageError) Error() string {
+	return fmt.Sprintf("stage %q failed on element %d: %v", err.Stage, err.Index, err.Err)
+}
+
+func (err *StageError) Unwrap() error {
+	return err.Err
+}
+
+/*
+Traverse creates a Stage named name applying f to each element and stopping at the first failed Try.
+Example:
+Traverse("parse", func(s string) try.Try[int] { return try.Pure(strconv.Atoi(s)) })
+*/
+func Traverse[T any, R any](name string, f func(T) try.Try[R]) Stage[T, R] {
+	return Stage[T, R]{
+		name: name,
+		run: func(values []indexed[T]) ([]indexed[R], error) {
+			results := make([]indexed[R], 0, len(values))
+			for _, v := range values {
+				var err error
+				try.BiForEach(f(v.value), func(e error) {
+					err = &StageError{Stage: name, Index: v.index, Err: e}
+				}, func(r R) {
+					results = append(results, indexed[R]{index: v.index, value: r})
+				})
+				if err != nil {
+					return nil, err
+				}
+			}
+			return results, nil
+		},
+	}
+}
+
+/*
+Map creates a Stage named name applying f to each element.
+Example: Map("double", func(n int) int { return n * 2 })
+*/
+func Map[T any, R any](name string, f func(T) R) Stage[T, R] {
+	return Traverse(name, func(t T) try.Try[R] {
+		return try.Success(f(t))
+	})
+}
+
+/*
+Filter creates a Stage named name keeping only the elements that satisfy the given predicate function.
+Example: Filter("even", func(n int) bool { return n % 2 == 0 })
+*/
+func Filter[T any](name string, f func(T) bool) Stage[T, T] {
+	return Stage[T, T]{
+		name: name,
+		run: func(values []indexed[T]) ([]indexed[T], error) {
+			results := make([]indexed[T], 0, len(values))
+			for _, v := range values {
+				if f(v.value) {
+					results = append(results, v)
+				}
+			}
+			return results, nil
+		},
+	}
+}
+
+/*
+Name returns the name of the stage.
+Example: Map("double", double).Name() returns "double"
+*/
+func (stage Stage[T, R]) Name() string {
+	return stage.name
+}
+
+/*
+Then chains two stages: the elements produced by first are given to second.
+The resulting Stage is named after both stages, and a failure still reports the name of the stage that failed.
+Example: Then(Traverse("parse", parse), Filter("positive", isPositive)) returns a Stage named "parse > positive"
+*/
+func Then[A any, B any, C any](first Stage[A, B], second Stage[B, C]) Stage[A, C] {
+	return Stage[A, C]{
+		name: first.name + " > " + second.name,
+		run: func(values []indexed[A]) ([]indexed[C], error) {
+			intermediate, err := first.run(values)
+			if err != nil {
+				return nil, err
+			}
+			return second.run(intermediate)
+		},
+	}
+}
+
+/*
+Run applies the stage to the elements of the list.
+If a stage fails, it returns a failed Try holding a *StageError.
+Examples:
+Run(Then(Traverse("parse", parse), Map("double", double)), list.Of("1", "2")) returns Success(List[int]([2,4]))
+Run(Then(Traverse("parse", parse), Map("double", double)), list.Of("1", "x")) returns Fail(stage "parse" failed on element 1: ...)
+*/
+func Run[T any, R any](stage Stage[T, R], l list.List[T]) try.Try[list.List[R]] {
+	values := l.ToArray()
+	input := make([]indexed[T], len(values))
+	for i, v := range values {
+		input[i] = indexed[T]{index: i, value: v}
+	}
+	output, err := stage.run(input)
+	if err != nil {
+		return try.Fail[list.List[R]](err)
+	}
+	results := make([]R, len(output))
+	for i, r := range output {
+		results[i] = r.value
+	}
+	return try.Success(list.Pure(results))
+}
@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"errors"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/try"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func parse(s string) try.Try[int] {
+	return try.Pure(strconv.Atoi(s))
+}
+
+func TestRun(t *testing.T) {
+	stage := Then(Then(Traverse("parse", parse), Filter("positive", func(n int) bool { return n > 0 })),
+		Map("double", func(n int) int { return n * 2 }))
+	got := Run(stage, list.Of("1", "-2", "3"))
+	if !got.IsSuccess() || !reflect.DeepEqual(got.GetOrElse(list.Empty[int]()).ToArray(), []int{2, 6}) {
+		t.Errorf("Run returns %v, want Success([2 6])", got)
+	}
+	if name := stage.Name(); name != "parse > positive > double" {
+		t.Errorf("stage is named %q, want %q", name, "parse > positive > double")
+	}
+}
+
+func TestStageError(t *testing.T) {
+	errOdd := errors.New("odd")
+	check := Traverse("check", func(n int) try.Try[int] {
+		if n%2 != 0 {
+			return try.Fail[int](errOdd)
+		}
+		return try.Success(n)
+	})
+	tests := []struct {
+		name      string
+		stage     Stage[string, int]
+		input     list.List[string]
+		wantStage string
+		wantIndex int
+		wantErr   error
+	}{
+		{"first stage", Then(Traverse("parse", parse), check), list.Of("2", "x", "4"), "parse", 1, strconv.ErrSyntax},
+		{"second stage", Then(Traverse("parse", parse), check), list.Of("2", "4", "5"), "check", 2, errOdd},
+		{"index of the input after a filter",
+			Then(Then(Traverse("parse", parse), Filter("small", func(n int) bool { return n < 10 })), check),
+			list.Of("20", "30", "2", "3"), "check", 3, errOdd},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Run(tt.stage, tt.input)
+			if !result.IsFail() {
+				t.Fatalf("Run returns %v, want a failure", result)
+			}
+			err := try.Fold(result, func(err error) error { return err }, func(list.List[int]) error { return nil })
+			var stageErr *StageError
+			if !errors.As(err, &stageErr) {
+				t.Fatalf("Run fails with %T, want a *StageError", err)
+			}
+			if stageErr.Stage != tt.wantStage || stageErr.Index != tt.wantIndex {
+				t.Errorf("StageError reports stage %q and index %d, want %q and %d",
+					stageErr.Stage, stageErr.Index, tt.wantStage, tt.wantIndex)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StageError wraps %v, want %v", stageErr.Err, tt.wantErr)
+			}
+		})
+	}
+}
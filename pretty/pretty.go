@@ -0,0 +1,267 @@
+/*
+Package pretty renders nested containers as indented trees with a stable output, for golden-file tests and readable logs.
+Lists, Options, Eithers, Trys, Tuples and Monads show their structure, the elements of Sets and the keys of maps are sorted
+(by value for numbers and strings, by rendering otherwise), and values nested in structs, slices, arrays and pointers
+are rendered the same way.
+A node fitting on a line is kept inline; otherwise each of its children goes on its own line, indented by two spaces.
+
+Example: Sprint(list.Of(set.Of(2, 1), set.Of(3))) returns "List(Set(1, 2), Set(3))"
+*/
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// width is the length above which a node is split over several lines.
+const width = 80
+
+const modulePath = "github.com/Sugther/go-structs/"
+
+/*
+Sprint returns the rendering of v.
+Examples:
+Sprint(option.Pure(list.Of("a", "b"))) returns `Some(List("a", "b"))`
+Sprint(set.Of(3, 1, 2)) returns "Set(1, 2, 3)"
+*/
+func Sprint(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+	value := reflect.New(reflect.TypeOf(v)).Elem()
+	value.Set(reflect.ValueOf(v))
+	return render(value, map[uintptr]bool{})
+}
+
+/*
+Fprint writes the rendering of v followed by a newline to w.
+Example: Fprint(os.Stderr, either.Right[string](42)) writes "Right(42)\n"
+*/
+func Fprint(w io.Writer, v interface{}) error {
+	_, err := io.WriteString(w, Sprint(v)+"\n")
+	return err
+}
+
+/*
+Print writes the rendering of v followed by a newline to the standard output.
+Example: Print(tuple.Pure(1, "a")) prints `Tuple(1, "a")`
+*/
+func Print(v interface{}) error {
+	return Fprint(os.Stdout, v)
+}
+
+// render returns the rendering of value. visiting holds the pointers being rendered, to cut cycles.
+func render(value reflect.Value, visiting map[uintptr]bool) string {
+	value = usable(value)
+	if !value.CanInterface() {
+		return fmt.Sprint(value)
+	}
+	if !value.CanAddr() && (value.Kind() == reflect.Struct || value.Kind() == reflect.Array) {
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	if s, ok := renderContainer(value, visiting); ok {
+		return s
+	}
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() {
+			return "nil"
+		}
+		return render(value.Elem(), visiting)
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "nil"
+		}
+		if s, ok := stringer(value); ok && !strings.HasPrefix(value.Type().Elem().PkgPath(), modulePath) {
+			return s
+		}
+		if visiting[value.Pointer()] {
+			return "<cycle>"
+		}
+		visiting[value.Pointer()] = true
+		defer delete(visiting, value.Pointer())
+		return "&" + render(value.Elem(), visiting)
+	}
+	if s, ok := stringer(value); ok {
+		return s
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return strconv.Quote(value.String())
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return "nil"
+		}
+		return group("[", "]", elements(value, visiting))
+	case reflect.Map:
+		if value.IsNil() {
+			return "nil"
+		}
+		keys := reflect.MakeSlice(reflect.SliceOf(value.Type().Key()), 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			keys = reflect.Append(keys, iter.Key())
+		}
+		renderedKeys := elements(keys, visiting)
+		entries := make([]string, len(renderedKeys))
+		for i, k := range order(keys, renderedKeys) {
+			entries[i] = renderedKeys[k] + ": " + render(value.MapIndex(keys.Index(k)), visiting)
+		}
+		return group("map[", "]", entries)
+	case reflect.Struct:
+		fields := make([]string, value.NumField())
+		for i := range fields {
+			fields[i] = value.Type().Field(i).Name + ": " + render(value.Field(i), visiting)
+		}
+		name := value.Type().Name()
+		if name == "" {
+			name = "struct"
+		}
+		return group(name+"{", "}", fields)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if value.IsNil() {
+			return "nil"
+		}
+		return "<" + value.Type().String() + ">"
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// renderContainer renders the containers of go-structs, and reports whether value is one of them.
+func renderContainer(value reflect.Value, visiting map[uintptr]bool) (string, bool) {
+	t := value.Type()
+	if !strings.HasPrefix(t.PkgPath(), modulePath) {
+		return "", false
+	}
+	name, _, _ := strings.Cut(t.Name(), "[")
+	switch strings.TrimPrefix(t.PkgPath(), modulePath) + "." + name {
+	case "list.List", "persistent.List", "sortedset.SortedSet":
+		return group(name+"(", ")", elements(call(value, "ToArray"), visiting)), true
+	case "set.Set", "hashset.HashSet":
+		return group(name+"(", ")", sortedElements(call(value, "ToArray"), visiting)), true
+	case "option.Option":
+		if !call(value, "IsPresent").Bool() {
+			return "None", true
+		}
+		return group("Some(", ")", []string{render(call(value, "Get"), visiting)}), true
+	case "either.Either":
+		if !call(value, "IsValid").Bool() {
+			return "Invalid", true
+		}
+		if call(value, "IsRight").Bool() {
+			return group("Right(", ")", []string{render(call(value.FieldByName("Right"), "Get"), visiting)}), true
+		}
+		return group("Left(", ")", []string{render(call(value.FieldByName("Left"), "Get"), visiting)}), true
+	case "try.Try":
+		if !call(value, "IsValid").Bool() {
+			return "Invalid", true
+		}
+		e := call(value, "ToEither")
+		if call(e, "IsRight").Bool() {
+			return group("Success(", ")", []string{render(call(e.FieldByName("Right"), "Get"), visiting)}), true
+		}
+		return group("Fail(", ")", []string{render(call(e.FieldByName("Left"), "Get"), visiting)}), true
+	case "tuple.Tuple":
+		values := value.MethodByName("Values").Call(nil)
+		return group("Tuple(", ")", []string{render(values[0], visiting), render(values[1], visiting)}), true
+	case "monad.Monad":
+		return group("Monad(", ")", []string{render(call(value, "Get"), visiting)}), true
+	}
+	return "", false
+}
+
+func call(value reflect.Value, method string) reflect.Value {
+	return value.MethodByName(method).Call(nil)[0]
+}
+
+// stringer renders values implementing error or fmt.Stringer, such as time.Time or *big.Int, with their own method.
+func stringer(value reflect.Value) (string, bool) {
+	switch v := value.Interface().(type) {
+	case error:
+		return v.Error(), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
+
+func elements(value reflect.Value, visiting map[uintptr]bool) []string {
+	children := make([]string, value.Len())
+	for i := range children {
+		children[i] = render(value.Index(i), visiting)
+	}
+	return children
+}
+
+// sortedElements renders the elements of a slice, sorted by value for numbers and strings, and by rendering otherwise.
+func sortedElements(value reflect.Value, visiting map[uintptr]bool) []string {
+	children := elements(value, visiting)
+	sorted := make([]string, len(children))
+	for i, k := range order(value, children) {
+		sorted[i] = children[k]
+	}
+	return sorted
+}
+
+// order returns the indexes of the elements of a slice in sorted order, given their renderings.
+func order(value reflect.Value, renderings []string) []int {
+	indexes := make([]int, len(renderings))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return less(value.Index(indexes[i]), value.Index(indexes[j]), renderings[indexes[i]], renderings[indexes[j]])
+	})
+	return indexes
+}
+
+func less(a reflect.Value, b reflect.Value, renderingA string, renderingB string) bool {
+	if a.Kind() == reflect.Interface && !a.IsNil() && !b.IsNil() {
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		}
+	}
+	return renderingA < renderingB
+}
+
+// usable returns value, or a copy of it that can be used with Interface and Call if it was read from an unexported field.
+func usable(value reflect.Value) reflect.Value {
+	if value.CanInterface() || !value.CanAddr() {
+		return value
+	}
+	return reflect.NewAt(value.Type(), unsafe.Pointer(value.UnsafeAddr())).Elem()
+}
+
+// group renders a node inline if it fits on a line, or with a child per line otherwise.
+func group(open string, close string, children []string) string {
+	inline := open + strings.Join(children, ", ") + close
+	if len(inline) <= width && !strings.Contains(inline, "\n") {
+		return inline
+	}
+	var builder strings.Builder
+	builder.WriteString(open + "\n")
+	for _, child := range children {
+		builder.WriteString("  " + strings.ReplaceAll(child, "\n", "\n  ") + "\n")
+	}
+	builder.WriteString(close)
+	return builder.String()
+}
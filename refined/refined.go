@@ -0,0 +1,162 @@
+package refined
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Sugther/go-structs/either"
+	"strconv"
+)
+
+/*
+ErrEmptyString is the error returned as a Left by NewNonEmptyString.
+*/
+var ErrEmptyString = errors.New("refined: empty string")
+
+/*
+ErrNotPositive is the error wrapped in the Left returned by NewPositiveInt.
+*/
+var ErrNotPositive = errors.New("refined: not a positive integer")
+
+/*
+ErrOutOfRange is the error wrapped in the Left returned by NewPercent and NewPort.
+*/
+var ErrOutOfRange = errors.New("refined: out of range")
+
+/*
+NonEmptyString is a string that was checked not to be empty when it was created with NewNonEmptyString.
+*/
+type NonEmptyString struct {
+	value string
+}
+
+/*
+NewNonEmptyString returns the given string as a NonEmptyString in a Right, or ErrEmptyString in a Left if it is empty.
+Examples:
+NewNonEmptyString("go") returns Right[error, NonEmptyString]("go")
+NewNonEmptyString("") returns Left[error, NonEmptyString](ErrEmptyString)
+*/
+func NewNonEmptyString(s string) either.Either[error, NonEmptyString] {
+	if s == "" {
+		return either.Left[error, NonEmptyString](ErrEmptyString)
+	}
+	return either.Right[error](NonEmptyString{value: s})
+}
+
+/*
+Get returns the underlying string.
+Example: NewNonEmptyString("go").Right.Get().Get() returns "go"
+*/
+func (s NonEmptyString) Get() string {
+	return s.value
+}
+
+func (s NonEmptyString) String() string {
+	return s.value
+}
+
+/*
+PositiveInt is an int that was checked to be strictly greater than zero when it was created with NewPositiveInt.
+*/
+type PositiveInt struct {
+	value int
+}
+
+/*
+NewPositiveInt returns the given int as a PositiveInt in a Right, or an error wrapping ErrNotPositive in a Left
+if it is zero or negative.
+Examples:
+NewPositiveInt(42) returns Right[error, PositiveInt](42)
+NewPositiveInt(0) returns Left[error, PositiveInt](ErrNotPositive: 0)
+*/
+func NewPositiveInt(n int) either.Either[error, PositiveInt] {
+	if n <= 0 {
+		return either.Left[error, PositiveInt](fmt.Errorf("%w: %d", ErrNotPositive, n))
+	}
+	return either.Right[error](PositiveInt{value: n})
+}
+
+/*
+Get returns the underlying int.
+Example: NewPositiveInt(42).Right.Get().Get() returns 42
+*/
+func (n PositiveInt) Get() int {
+	return n.value
+}
+
+func (n PositiveInt) String() string {
+	return strconv.Itoa(n.value)
+}
+
+/*
+Percent is a float64 that was checked to be between 0 and 100 inclusive when it was created with NewPercent.
+*/
+type Percent struct {
+	value float64
+}
+
+/*
+NewPercent returns the given float64 as a Percent in a Right, or an error wrapping ErrOutOfRange in a Left
+if it is not between 0 and 100 inclusive. NaN is out of range.
+Examples:
+NewPercent(12.5) returns Right[error, Percent](12.5)
+NewPercent(120) returns Left[error, Percent](ErrOutOfRange: 120)
+*/
+func NewPercent(f float64) either.Either[error, Percent] {
+	if !(f >= 0 && f <= 100) {
+		return either.Left[error, Percent](fmt.Errorf("%w: percent %v", ErrOutOfRange, f))
+	}
+	return either.Right[error](Percent{value: f})
+}
+
+/*
+Get returns the underlying float64.
+Example: NewPercent(12.5).Right.Get().Get() returns 12.5
+*/
+func (p Percent) Get() float64 {
+	return p.value
+}
+
+/*
+Ratio returns the percentage as a fraction between 0 and 1.
+Example: NewPercent(12.5).Right.Get().Ratio() returns 0.125
+*/
+func (p Percent) Ratio() float64 {
+	return p.value / 100
+}
+
+func (p Percent) String() string {
+	return strconv.FormatFloat(p.value, 'g', -1, 64) + "%"
+}
+
+/*
+Port is a TCP or UDP port number that was checked to be between 1 and 65535 when it was created with NewPort.
+*/
+type Port struct {
+	value uint16
+}
+
+/*
+NewPort returns the given int as a Port in a Right, or an error wrapping ErrOutOfRange in a Left
+if it is not between 1 and 65535.
+Examples:
+NewPort(8080) returns Right[error, Port](8080)
+NewPort(70000) returns Left[error, Port](ErrOutOfRange: 70000)
+*/
+func NewPort(n int) either.Either[error, Port] {
+	if n < 1 || n > 65535 {
+		return either.Left[error, Port](fmt.Errorf("%w: port %d", ErrOutOfRange, n))
+	}
+	return either.Right[error](Port{value: uint16(n)})
+}
+
+/*
+Get returns the underlying port number.
+Example: NewPort(8080).Right.Get().Get() returns 8080
+*/
+func (p Port) Get() int {
+	return int(p.value)
+}
+
+func (p Port) String() string {
+	return strconv.Itoa(int(p.value))
+}
@@ -0,0 +1,154 @@
+package ring
+
+import (
+	"fmt"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/persistent"
+)
+
+/*
+Ring is an immutable buffer of fixed capacity holding the last values of type T pushed into it, from the oldest to the newest.
+Pushing into a full Ring evicts its oldest values, which makes it suited to keep track of the last N events.
+Its values are held in a persistent List, so Push returns a new Ring sharing them with the original one in O(log n).
+*/
+type Ring[T any] struct {
+	values   persistent.List[T]
+	capacity int
+}
+
+/*
+New creates a new empty Ring holding at most capacity values. It panics if capacity is not positive.
+Example: New[int](3) returns Ring[int](capacity: 3, [])
+*/
+func New[T any](capacity int) Ring[T] {
+	if capacity <= 0 {
+		panic("ring: New with a non-positive capacity")
+	}
+	return Ring[T]{values: persistent.Empty[T](), capacity: capacity}
+}
+
+/*
+Capacity returns the maximum number of values the Ring holds.
+Example: Capacity(New[int](3)) returns 3
+*/
+func Capacity[T any](ring Ring[T]) int {
+	return ring.capacity
+}
+
+func (ring Ring[T]) Capacity() int {
+	return Capacity(ring)
+}
+
+/*
+Len returns the number of values held by the Ring, which never exceeds its capacity.
+Example: Len(New[int](3).Push(1, 2)) returns 2
+*/
+func Len[T any](ring Ring[T]) int {
+	return ring.values.Len()
+}
+
+func (ring Ring[T]) Len() int {
+	return Len(ring)
+}
+
+/*
+IsEmpty returns true if the Ring holds no value, false otherwise.
+Example: IsEmpty(New[int](3)) returns true
+*/
+func IsEmpty[T any](ring Ring[T]) bool {
+	return Len(ring) == 0
+}
+
+func (ring Ring[T]) IsEmpty() bool {
+	return IsEmpty(ring)
+}
+
+/*
+IsFull returns true if the Ring holds as many values as its capacity, so that the next Push evicts the oldest one.
+Example: IsFull(New[int](2).Push(1, 2)) returns true
+*/
+func IsFull[T any](ring Ring[T]) bool {
+	return Len(ring) == Capacity(ring)
+}
+
+func (ring Ring[T]) IsFull() bool {
+	return IsFull(ring)
+}
+
+/*
+Push returns a new Ring with the given values added as the newest ones, in order, evicting the oldest values beyond its capacity.
+Examples:
+Push(New[int](3), 1, 2) returns Ring[int](capacity: 3, [1,2])
+Push(New[int](3).Push(1, 2), 3, 4) returns Ring[int](capacity: 3, [2,3,4])
+*/
+func Push[T any](ring Ring[T], values ...T) Ring[T] {
+	if len(values) > ring.capacity {
+		values = values[len(values)-ring.capacity:]
+	}
+	result := ring.values.Append(values...)
+	for result.Len() > ring.capacity {
+		result = result.Remove(0)
+	}
+	return Ring[T]{values: result, capacity: ring.capacity}
+}
+
+func (ring Ring[T]) Push(values ...T) Ring[T] {
+	return Push(ring, values...)
+}
+
+/*
+Oldest returns the oldest value of the Ring wrapped in an Option, or an empty Option if the Ring is empty.
+Examples:
+Oldest(New[int](3).Push(1, 2)) returns Option[int](1)
+Oldest(New[int](3)) returns Option[int]{isPresent: false}
+*/
+func Oldest[T any](ring Ring[T]) option.Option[T] {
+	return ring.values.Get(0)
+}
+
+func (ring Ring[T]) Oldest() option.Option[T] {
+	return Oldest(ring)
+}
+
+/*
+Newest returns the newest value of the Ring wrapped in an Option, or an empty Option if the Ring is empty.
+Examples:
+Newest(New[int](3).Push(1, 2)) returns Option[int](2)
+Newest(New[int](3)) returns Option[int]{isPresent: false}
+*/
+func Newest[T any](ring Ring[T]) option.Option[T] {
+	return ring.values.Get(Len(ring) - 1)
+}
+
+func (ring Ring[T]) Newest() option.Option[T] {
+	return Newest(ring)
+}
+
+/*
+Fold applies a function to each value of the Ring from the oldest to the newest, accumulating a result from the root value.
+Example: Fold(New[int](2).Push(1, 2, 3), 0, func(acc int, v int) int { return acc + v }) returns 5
+*/
+func Fold[T any, R any](ring Ring[T], root R, f func(R, T) R) R {
+	return persistent.Fold(ring.values, root, f)
+}
+
+/*
+ToList returns the values of the Ring as a list.List, from the oldest to the newest.
+Example: ToList(New[int](2).Push(1, 2, 3)) returns List[int]([2,3])
+*/
+func ToList[T any](ring Ring[T]) list.List[T] {
+	return ring.values.ToList()
+}
+
+func (ring Ring[T]) ToList() list.List[T] {
+	return ToList(ring)
+}
+
+/*
+String returns a readable representation of the Ring, implementing fmt.Stringer.
+Example: New[int](3).Push(1, 2).String() returns "Ring(3)[1 2]"
+*/
+func (ring Ring[T]) String() string {
+	return fmt.Sprintf("Ring(%d)%v", ring.capacity, ring.values.ToArray())
+}
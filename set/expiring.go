@@ -0,0 +1,192 @@
+package set
+
+import (
+	"context"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+Expiring is a mutable set of comparable values of type T in which each value expires at a deadline,
+for instance to deduplicate the messages of a stream over a time window. It is safe for concurrent use.
+Expired values are removed lazily when they are looked up, by Cleanup, or in the background by StartCleanup.
+Snapshot returns the values still alive as an immutable Set.
+An Expiring must be created with NewExpiring and must not be copied after first use.
+*/
+type Expiring[T comparable] struct {
+	mutex     sync.Mutex
+	ttl       time.Duration
+	deadlines map[T]expiry
+	added     uint64
+	now       func() time.Time
+}
+
+// expiry is the deadline of a value of an Expiring set, and the order in which the value was added to break ties.
+type expiry struct {
+	deadline time.Time
+	order    uint64
+}
+
+/*
+NewExpiring creates a new empty Expiring set whose values expire after the given time to live by default.
+It panics if ttl is not positive.
+Example: NewExpiring[string](time.Minute) returns an empty *Expiring[string]
+*/
+func NewExpiring[T comparable](ttl time.Duration) *Expiring[T] {
+	if ttl <= 0 {
+		panic("set: NewExpiring with a non-positive time to live")
+	}
+	return &Expiring[T]{ttl: ttl, deadlines: map[T]expiry{}, now: time.Now}
+}
+
+/*
+Add adds the given values to the set, expiring after its default time to live.
+Values already in the set get their deadline pushed back.
+Example: s.Add("a", "b") makes s contain "a" and "b" for its time to live
+*/
+func (set *Expiring[T]) Add(values ...T) {
+	set.AddWithTTL(set.ttl, values...)
+}
+
+/*
+AddWithTTL adds the given values to the set, expiring after the given time to live instead of the default one.
+Values already in the set get the new deadline.
+Example: s.AddWithTTL(time.Second, "a") makes s contain "a" for a second
+*/
+func (set *Expiring[T]) AddWithTTL(ttl time.Duration, values ...T) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	deadline := set.now().Add(ttl)
+	for _, v := range values {
+		set.added++
+		set.deadlines[v] = expiry{deadline: deadline, order: set.added}
+	}
+}
+
+/*
+Remove removes the given values from the set before their deadline.
+Example: s.Remove("a") makes s no longer contain "a"
+*/
+func (set *Expiring[T]) Remove(values ...T) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	for _, v := range values {
+		delete(set.deadlines, v)
+	}
+}
+
+/*
+Contains returns true if the given value is in the set and has not expired. An expired value is removed.
+Example: NewExpiring[string](time.Minute).Contains("a") returns false
+*/
+func (set *Expiring[T]) Contains(value T) bool {
+	return set.Deadline(value).IsPresent()
+}
+
+/*
+Deadline returns the time at which the given value expires wrapped in an Option,
+or an empty Option if it is not in the set or has expired. An expired value is removed.
+Example: NewExpiring[string](time.Minute).Deadline("a") returns Option[time.Time]{isPresent: false}
+*/
+func (set *Expiring[T]) Deadline(value T) option.Option[time.Time] {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	e, ok := set.deadlines[value]
+	if !ok {
+		return option.Empty[time.Time]()
+	}
+	if !set.now().Before(e.deadline) {
+		delete(set.deadlines, value)
+		return option.Empty[time.Time]()
+	}
+	return option.Pure(e.deadline)
+}
+
+/*
+Len returns the number of values in the set that have not expired, removing the expired ones.
+Example: NewExpiring[string](time.Minute).Len() returns 0
+*/
+func (set *Expiring[T]) Len() int {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.removeExpired()
+	return len(set.deadlines)
+}
+
+/*
+Cleanup removes the expired values from the set and returns how many were removed.
+Example: s.Cleanup() returns 2 if two values of s have expired
+*/
+func (set *Expiring[T]) Cleanup() int {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	return set.removeExpired()
+}
+
+/*
+StartCleanup calls Cleanup every interval in a new goroutine until the context is done,
+so that expired values which are never looked up again do not accumulate.
+Example: s.StartCleanup(ctx, time.Minute) removes the expired values of s every minute until ctx is cancelled
+*/
+func (set *Expiring[T]) StartCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				set.Cleanup()
+			}
+		}
+	}()
+}
+
+/*
+Snapshot returns the values of the set that have not expired as an immutable Set, from the first to expire to the last.
+Values expiring at the same time, such as those added by the same call to Add, are in the order they were added.
+Later changes to the Expiring set do not affect the returned Set.
+Example: s.Snapshot() returns Set[string](["a","b"]) if "a" and "b" are alive in s and "a" expires first
+*/
+func (set *Expiring[T]) Snapshot() Set[T] {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.removeExpired()
+	values := make([]T, 0, len(set.deadlines))
+	for v := range set.deadlines {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		e1, e2 := set.deadlines[values[i]], set.deadlines[values[j]]
+		if e1.deadline.Equal(e2.deadline) {
+			return e1.order < e2.order
+		}
+		return e1.deadline.Before(e2.deadline)
+	})
+	return pure(values)
+}
+
+/*
+String returns a readable representation of the values of the set that have not expired, implementing fmt.Stringer.
+Example: s.String() returns "Expiring(a, b)" if "a" and "b" are alive in s and "a" expires first
+*/
+func (set *Expiring[T]) String() string {
+	return list.MkStringWith(set.Snapshot().list, "Expiring(", ", ", ")")
+}
+
+// removeExpired removes the expired values and returns how many were removed. The caller must hold the mutex.
+func (set *Expiring[T]) removeExpired() int {
+	now := set.now()
+	removed := 0
+	for v, e := range set.deadlines {
+		if !now.Before(e.deadline) {
+			delete(set.deadlines, v)
+			removed++
+		}
+	}
+	return removed
+}
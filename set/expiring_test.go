@@ -0,0 +1,85 @@
+package set
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// clock is a fake time source for Expiring sets, advanced by hand.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newExpiringAt[T comparable](c *clock, ttl time.Duration) *Expiring[T] {
+	set := NewExpiring[T](ttl)
+	set.now = func() time.Time { return c.now }
+	return set
+}
+
+func TestExpiring(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := newExpiringAt[string](c, time.Minute)
+	set.Add("a", "b")
+	set.AddWithTTL(time.Second, "c")
+	if got := set.Deadline("c"); got.GetOrElse(time.Time{}) != c.now.Add(time.Second) {
+		t.Errorf("Deadline(c) returns %v, want in a second", got)
+	}
+	if set.Len() != 3 || !set.Contains("c") {
+		t.Fatalf("set holds %v, want a, b and c", set)
+	}
+
+	c.advance(time.Second)
+	if set.Contains("c") {
+		t.Error("c is still in the set at its deadline")
+	}
+	c.advance(30 * time.Second)
+	set.Add("a")
+	c.advance(30 * time.Second)
+	if set.Contains("b") || !set.Contains("a") {
+		t.Errorf("after a minute, the set holds %v, want only a whose deadline was pushed back", set.Snapshot())
+	}
+	set.Remove("a")
+	if set.Len() != 0 {
+		t.Errorf("set holds %v after removing a, want nothing", set.Snapshot())
+	}
+}
+
+func TestExpiringCleanup(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := newExpiringAt[int](c, time.Minute)
+	set.Add(1, 2)
+	set.AddWithTTL(time.Hour, 3)
+	if removed := set.Cleanup(); removed != 0 {
+		t.Errorf("Cleanup removed %d values before any deadline", removed)
+	}
+	c.advance(time.Minute)
+	if removed := set.Cleanup(); removed != 2 {
+		t.Errorf("Cleanup removed %d values, want 2", removed)
+	}
+	if got := set.Snapshot().ToArray(); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("Snapshot returns %v, want [3]", got)
+	}
+}
+
+func TestExpiringSnapshotOrder(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := newExpiringAt[string](c, time.Minute)
+	set.Add("e", "c", "a", "d", "b")
+	set.AddWithTTL(time.Second, "z")
+	c.advance(time.Millisecond)
+	set.AddWithTTL(time.Second, "y")
+	want := []string{"z", "y", "e", "c", "a", "d", "b"}
+	for i := 0; i < 20; i++ {
+		if got := set.Snapshot().ToArray(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Snapshot returns %v, want %v", got, want)
+		}
+	}
+	if got := set.String(); got != "Expiring(z, y, e, c, a, d, b)" {
+		t.Errorf("String returns %q", got)
+	}
+}
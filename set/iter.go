@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+/*
+Seq returns an iterator over the elements of the set, in the order of the set, so it can be ranged over directly.
+The order of the set is the order in which its elements were first added.
+Example: for v := range Seq(Of(1, 2, 3)) { fmt.Println(v) } prints 1, 2 and 3
+*/
+func Seq[T any](set Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range set.list.ToArray() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (set Set[T]) Seq() iter.Seq[T] {
+	return Seq(set)
+}
+
+/*
+Iter returns the same iterator as Seq, so that a Set can be used like the other containers.
+Example: for v := range Of(1, 2, 3).Iter() { fmt.Println(v) } prints 1, 2 and 3
+*/
+func (set Set[T]) Iter() iter.Seq[T] {
+	return Seq(set)
+}
+
+/*
+FromSeq creates a new Set containing the values produced by the given iterator. Duplicate values are removed.
+Example: FromSeq(slices.Values([]int{1, 2, 2, 3})) returns Set[int]([1,2,3])
+*/
+func FromSeq[T any](seq iter.Seq[T]) Set[T] {
+	var values []T
+	for v := range seq {
+		values = append(values, v)
+	}
+	return Pure(values)
+}
@@ -1,14 +1,21 @@
 package set
 
 import (
+	"encoding/json"
+	"github.com/Sugther/go-structs/either"
+	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
 	"github.com/Sugther/go-structs/list"
 	"github.com/Sugther/go-structs/option"
+	"github.com/Sugther/go-structs/tuple"
+	"sort"
 )
 
 /*
 Set is a generic struct representing a list of unique values of type T.
 The elements has to be Equal or comparable
 It doesn't necessarily have to respect order to be equal.
+The zero value is an empty Set ready to use.
 */
 type Set[T any] struct {
 	list list.List[T]
@@ -60,6 +67,14 @@ func Empty[T any]() Set[T] {
 	return pure([]T{})
 }
 
+/*
+FromMapKeys creates a new Set containing the keys of the given map, in an unspecified order.
+Example: FromMapKeys(map[string]int{"a": 1, "b": 2}) returns Set[string](["a","b"]) in any order
+*/
+func FromMapKeys[K comparable, V any](m map[K]V) Set[K] {
+	return Distinct(list.FromMapKeys(m))
+}
+
 /*
 Len returns the length of the given set.
 Example: Len(Of(1, 2, 3, 3)) returns 3
@@ -105,13 +120,13 @@ Append returns a new Set with the given values appended to the original set.
 Example: Append(Of(1, 2, 3), 3, 4, 5) returns List[int]([1,2,3,4,5])
 */
 func Append[T any](set Set[T], values ...T) Set[T] {
-	valuesList := list.Pure(values)
-	return list.Fold(valuesList, set, func(result Set[T], value T) Set[T] {
-		if list.Contains(result.list, value) {
-			return result
+	added := list.Fold(list.Pure(values), make([]T, 0, len(values)), func(added []T, value T) []T {
+		if list.Contains(set.list, value) || list.Contains(list.Pure(added), value) {
+			return added
 		}
-		return result.Append(value)
+		return append(added, value)
 	})
+	return pureList(list.AppendList(set.list, list.Pure(added)))
 }
 
 func (set Set[T]) Append(values ...T) Set[T] {
@@ -147,7 +162,7 @@ Head returns the first element of the set wrapped in an Option.
 If the set is empty, it returns an empty Option.
 Examples:
 Head(Of(1, 2, 3)) returns Option[int](1)
-Head(Empty[int]()) returns Option[int]{isEmpty: true}
+Head(Empty[int]()) returns Option[int]{isPresent: false}
 */
 func Head[T any](set Set[T]) option.Option[T] {
 	return list.Head(set.list)
@@ -169,6 +184,23 @@ func (set Set[T]) Tail() Set[T] {
 	return Tail(set)
 }
 
+/*
+Pop removes an arbitrary element of the set and returns it with the remaining Set, wrapped in an Option.
+If the set is empty, it returns an empty Option. It runs in O(1), which suits worklist algorithms.
+Examples:
+Pop(Of(1, 2, 3)) returns Option(Tuple(3, Set[int]([1,2])))
+Pop(Empty[int]()) returns Option{isPresent: false}
+*/
+func Pop[T any](set Set[T]) option.Option[tuple.Tuple[T, Set[T]]] {
+	return option.Map(list.Last(set.list), func(last T) tuple.Tuple[T, Set[T]] {
+		return tuple.Pure(last, pureList(list.Init(set.list)))
+	})
+}
+
+func (set Set[T]) Pop() option.Option[tuple.Tuple[T, Set[T]]] {
+	return Pop(set)
+}
+
 /*
 Fold applies a function to the elements of the set in a cumulative way, starting from the given root value.
 Examples:
@@ -179,6 +211,53 @@ func Fold[T any, R any](set Set[T], root R, f func(R, T) R) R {
 	return list.Fold(set.list, root, f)
 }
 
+/*
+Reduce combines the elements of the set in the order of the set, using the first element as the starting value.
+If the set is empty, it returns an empty Option.
+Examples:
+Reduce(Of(1, 2, 3), func(a int, b int) int { return a + b }) returns Option[int](6)
+Reduce(Empty[int](), func(a int, b int) int { return a + b }) returns Option[int]{isPresent: false}
+*/
+func Reduce[T any](set Set[T], f func(T, T) T) option.Option[T] {
+	return list.Reduce(set.list, f)
+}
+
+func (set Set[T]) Reduce(f func(T, T) T) option.Option[T] {
+	return Reduce(set, f)
+}
+
+/*
+MinBy returns the smallest element of the set according to the given less function, wrapped in an Option.
+If several elements are equally small, the first one in the order of the set is returned.
+If the set is empty, it returns an empty Option.
+Examples:
+MinBy(Of("bb", "a", "ccc"), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]("a")
+MinBy(Empty[string](), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]{isPresent: false}
+*/
+func MinBy[T any](set Set[T], less func(T, T) bool) option.Option[T] {
+	return list.MinBy(set.list, less)
+}
+
+func (set Set[T]) MinBy(less func(T, T) bool) option.Option[T] {
+	return MinBy(set, less)
+}
+
+/*
+MaxBy returns the greatest element of the set according to the given less function, wrapped in an Option.
+If several elements are equally great, the first one in the order of the set is returned.
+If the set is empty, it returns an empty Option.
+Examples:
+MaxBy(Of("bb", "a", "ccc"), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]("ccc")
+MaxBy(Empty[string](), func(a string, b string) bool { return len(a) < len(b) }) returns Option[string]{isPresent: false}
+*/
+func MaxBy[T any](set Set[T], less func(T, T) bool) option.Option[T] {
+	return list.MaxBy(set.list, less)
+}
+
+func (set Set[T]) MaxBy(less func(T, T) bool) option.Option[T] {
+	return MaxBy(set, less)
+}
+
 /*
 FlatMap applies a function that returns a Set for each element of the input set, then concatenates the resulting sets.
 Example:
@@ -202,6 +281,86 @@ func Map[T any, R any](set Set[T], f func(T) R) Set[R] {
 	return FlatMap(set, func(t T) Set[R] { return pure([]R{f(t)}) })
 }
 
+/*
+Collision describes distinct elements of a Set that MapChecked mapped to the same Result.
+*/
+type Collision[T any, R any] struct {
+	Result R
+	Inputs list.List[T]
+}
+
+/*
+MapChecked applies a function to each element of the set like Map, but instead of silently merging
+distinct elements mapped to the same result, it returns a Left holding every Collision.
+Results are compared with equal.Equals, through a map when equal.Comparable holds for R so that it takes linear time.
+Examples:
+MapChecked(Of(1, 2, 3), func(n int) int { return n * 10 }) returns Right(Set[int]([10,20,30]))
+MapChecked(Of(1, 2, 3), func(n int) int { return n % 2 }) returns Left(List[Collision[int, int]]([{1 [1,3]}]))
+*/
+func MapChecked[T any, R any](set Set[T], f func(T) R) either.Either[list.List[Collision[T, R]], Set[R]] {
+	results := []R{}
+	var inputs [][]T
+	comparable := equal.Comparable[R]()
+	indexes := map[interface{}]int{}
+	indexOf := func(r R) (int, bool) {
+		if comparable {
+			i, found := indexes[r]
+			return i, found
+		}
+		for i := range results {
+			if equal.Equals(results[i], r) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+	set.list.ForEachIndexed(func(_ int, t T) {
+		r := f(t)
+		if i, found := indexOf(r); found {
+			inputs[i] = append(inputs[i], t)
+			return
+		}
+		if comparable {
+			indexes[r] = len(results)
+		}
+		results = append(results, r)
+		inputs = append(inputs, []T{t})
+	})
+	var collisions []Collision[T, R]
+	for i := range results {
+		if len(inputs[i]) > 1 {
+			collisions = append(collisions, Collision[T, R]{Result: results[i], Inputs: list.Pure(inputs[i])})
+		}
+	}
+	if len(collisions) > 0 {
+		return either.Left[list.List[Collision[T, R]], Set[R]](list.Pure(collisions))
+	}
+	return either.Right[list.List[Collision[T, R]]](pure(results))
+}
+
+/*
+TraverseOption applies a function returning an Option to each element of the set and collects the values in a Set.
+It stops at the first empty Option and returns an empty Option in that case.
+Examples:
+TraverseOption(Of(1, 2, 3), func(n int) option.Option[int] { return option.Pure(n % 2) }) returns Option(Set[int]([1,0]))
+TraverseOption(Of(1, -2, 3), func(n int) option.Option[int] { return option.Filter(option.Pure(n), isPositive) }) returns Option[Set[int]]{isPresent: false}
+*/
+func TraverseOption[T any, R any](set Set[T], f func(T) option.Option[R]) option.Option[Set[R]] {
+	return option.Map(list.TraverseOption(set.list, f), func(l list.List[R]) Set[R] {
+		return Distinct(l)
+	})
+}
+
+/*
+SequenceOption turns a Set of Options into an Option of Set, which is empty as soon as one of the Options is empty.
+Examples:
+SequenceOption(Of(option.Pure(1), option.Pure(2))) returns Option(Set[int]([1,2]))
+SequenceOption(Of(option.Pure(1), option.Empty[int]())) returns Option[Set[int]]{isPresent: false}
+*/
+func SequenceOption[T any](set Set[option.Option[T]]) option.Option[Set[T]] {
+	return TraverseOption(set, func(o option.Option[T]) option.Option[T] { return o })
+}
+
 /*
 Filter returns a new Set containing only the elements that satisfy the given predicate function.
 Example: Filter(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns Set[int]([2,4])
@@ -214,6 +373,43 @@ func (set Set[T]) Filter(f func(T) bool) Set[T] {
 	return Filter(set, f)
 }
 
+/*
+Partition returns a Tuple of two new Sets: the elements that satisfy the given predicate function, and those that do not.
+Example: Partition(Of(1, 2, 3, 4, 5), func(n int) bool { return n % 2 == 0 }) returns Tuple(Set[int]([2,4]), Set[int]([1,3,5]))
+*/
+func Partition[T any](set Set[T], f func(T) bool) tuple.Tuple[Set[T], Set[T]] {
+	var matching, rest []T
+	for _, v := range set.list.ToArray() {
+		if f(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return tuple.Pure(pure(matching), pure(rest))
+}
+
+func (set Set[T]) Partition(f func(T) bool) tuple.Tuple[Set[T], Set[T]] {
+	return Partition(set, f)
+}
+
+/*
+GroupBy returns a map associating each key extracted with the given key function with the Set of elements having that key.
+Example: GroupBy(Of("a", "bb", "cc"), func(s string) int { return len(s) }) returns map[int]Set[string]{1: Set(["a"]), 2: Set(["bb","cc"])}
+*/
+func GroupBy[T any, K comparable](set Set[T], key func(T) K) map[K]Set[T] {
+	groups := map[K][]T{}
+	for _, v := range set.list.ToArray() {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	result := make(map[K]Set[T], len(groups))
+	for k, values := range groups {
+		result[k] = pure(values)
+	}
+	return result
+}
+
 /*
 Find returns the first element that satisfies the given predicate function, wrapped in an Option.
 If no elements satisfy the predicate, it returns an empty Option.
@@ -271,6 +467,35 @@ func (set Set[T]) Remove(f func(T) bool) Set[T] {
 	return Remove(set, f)
 }
 
+/*
+RemoveValue returns a new Set without the given value, compared with equal.Equals.
+Examples:
+RemoveValue(Of(1, 2, 3), 2) returns Set[int]([1,3])
+RemoveValue(Of(1, 2, 3), 4) returns Set[int]([1,2,3])
+*/
+func RemoveValue[T any](set Set[T], value T) Set[T] {
+	return RemoveAll(set, value)
+}
+
+func (set Set[T]) RemoveValue(value T) Set[T] {
+	return RemoveValue(set, value)
+}
+
+/*
+RemoveAll returns a new Set without any of the given values, compared with equal.Equals.
+Example: RemoveAll(Of(1, 2, 3, 4), 2, 4, 5) returns Set[int]([1,3])
+*/
+func RemoveAll[T any](set Set[T], values ...T) Set[T] {
+	removed := pure(values)
+	return Remove(set, func(t T) bool {
+		return Contains(removed, t)
+	})
+}
+
+func (set Set[T]) RemoveAll(values ...T) Set[T] {
+	return RemoveAll(set, values...)
+}
+
 /*
 Copy returns a new Set with all elements of the input set copied.
 Example:
@@ -298,18 +523,39 @@ func (set Set[T]) Sort(isInOrder func(T, T) bool) Set[T] {
 }
 
 /*
-toList converts a Set to a list.List containing the same elements as the input set.
+ToList converts a Set to a list.List containing the same elements as the input set.
 It maintains the order of elements in the original set.
 
 Example:
-toList(Of(1, 2, 3, 3)) returns list.List[int](1,2,3)
+ToList(Of(1, 2, 3, 3)) returns list.List[int](1,2,3)
 */
-func toList[T any](set Set[T]) list.List[T] {
+func ToList[T any](set Set[T]) list.List[T] {
 	return set.list
 }
 
-func (set Set[T]) toList() list.List[T] {
-	return toList(set)
+func (set Set[T]) ToList() list.List[T] {
+	return ToList(set)
+}
+
+/*
+ToArray returns a new slice with all elements of the input Set.
+Example:
+ToArray(Of(1, 2, 3, 3)) returns []int{1, 2, 3}
+*/
+func ToArray[T any](set Set[T]) []T {
+	return list.ToArray(set.list)
+}
+
+func (set Set[T]) ToArray() []T {
+	return ToArray(set)
+}
+
+/*
+ToMap returns a new map associating each element of the set with the result of f applied to it.
+Example: ToMap(Of("a", "bb"), func(s string) int { return len(s) }) returns map[string]int{"a": 1, "bb": 2}
+*/
+func ToMap[K comparable, V any](set Set[K], f func(K) V) map[K]V {
+	return list.ToMap(set.list, f)
 }
 
 /*
@@ -322,6 +568,34 @@ func Contains[T any](set Set[T], value T) bool {
 	return list.Contains(set.list, value)
 }
 
+/*
+ContainsAll returns true if every given value is present in the input Set, in a single pass over the Set.
+Examples:
+ContainsAll(Of("read", "write"), "write", "read") returns true
+ContainsAll(Of("read", "write"), "read", "admin") returns false
+*/
+func ContainsAll[T any](set Set[T], values ...T) bool {
+	return list.ContainsAll(set.list, values...)
+}
+
+func (set Set[T]) ContainsAll(values ...T) bool {
+	return ContainsAll(set, values...)
+}
+
+/*
+ContainsAny returns true if at least one of the given values is present in the input Set, in a single pass over the Set.
+Examples:
+ContainsAny(Of("read", "write"), "admin", "write") returns true
+ContainsAny(Of("read", "write"), "admin") returns false
+*/
+func ContainsAny[T any](set Set[T], values ...T) bool {
+	return list.ContainsAny(set.list, values...)
+}
+
+func (set Set[T]) ContainsAny(values ...T) bool {
+	return ContainsAny(set, values...)
+}
+
 /*
 Intersection returns a new Set containing the elements that are common between two input Sets.
 Example:
@@ -356,6 +630,170 @@ func (set Set[T]) Difference(set2 Set[T]) Set[T] {
 	return Difference(set, set2)
 }
 
+/*
+Union returns a new Set containing the elements of both input Sets: those of the first one, then those only in the second one.
+Example:
+set1 := Of[int](1, 2, 3)
+set2 := Of[int](2, 3, 4)
+Union(set1, set2) returns Set[int]([1,2,3,4])
+*/
+func Union[T any](set1 Set[T], set2 Set[T]) Set[T] {
+	return pureList(list.AppendList(set1.list, Difference(set2, set1).list))
+}
+
+func (set Set[T]) Union(set2 Set[T]) Set[T] {
+	return Union(set, set2)
+}
+
+/*
+SymmetricDifference returns a new Set containing the elements that are present in exactly one of the two input Sets.
+Example:
+set1 := Of[int](1, 2, 3)
+set2 := Of[int](2, 3, 4)
+SymmetricDifference(set1, set2) returns Set[int]([1,4])
+*/
+func SymmetricDifference[T any](set1 Set[T], set2 Set[T]) Set[T] {
+	return pureList(list.AppendList(Difference(set1, set2).list, Difference(set2, set1).list))
+}
+
+func (set Set[T]) SymmetricDifference(set2 Set[T]) Set[T] {
+	return SymmetricDifference(set, set2)
+}
+
+/*
+UnionAll returns a new Set containing the elements of all the input Sets.
+The elements of the Sets are concatenated once, from the largest Set to the smallest, Sets of the same size in the given order,
+then deduplicated like with Pure, which takes linear time for comparable elements.
+Example: UnionAll(Of(1, 2), Of(2, 3), Of(3, 4, 5)) returns Set[int]([3,4,5,1,2])
+*/
+func UnionAll[T any](sets ...Set[T]) Set[T] {
+	sorted := append([]Set[T]{}, sets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return Len(sorted[i]) > Len(sorted[j])
+	})
+	total := 0
+	for _, s := range sorted {
+		total += Len(s)
+	}
+	values := make([]T, 0, total)
+	for _, s := range sorted {
+		values = append(values, s.list.ToArray()...)
+	}
+	return Pure(values)
+}
+
+/*
+IntersectionAll returns a new Set containing the elements present in all the input Sets.
+The Sets are processed from the smallest to the largest, and the processing stops as soon as the intersection is empty.
+Example: IntersectionAll(Of(1, 2, 3), Of(2, 3, 4), Of(3, 2)) returns Set[int]([3,2])
+*/
+func IntersectionAll[T any](sets ...Set[T]) Set[T] {
+	if len(sets) == 0 {
+		return Empty[T]()
+	}
+	sorted := bySize(sets)
+	result := sorted[0]
+	for _, set := range sorted[1:] {
+		if IsEmpty(result) {
+			break
+		}
+		result = Intersection(result, set)
+	}
+	return result
+}
+
+// bySize returns a copy of the given Sets sorted by increasing length.
+func bySize[T any](sets []Set[T]) []Set[T] {
+	sorted := append([]Set[T]{}, sets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return Len(sorted[i]) < Len(sorted[j])
+	})
+	return sorted
+}
+
+/*
+IsSubsetOf returns true if every element of the first Set is present in the second Set.
+Examples:
+IsSubsetOf(Of(1, 2), Of(1, 2, 3)) returns true
+IsSubsetOf(Of(1, 4), Of(1, 2, 3)) returns false
+*/
+func IsSubsetOf[T any](set1 Set[T], set2 Set[T]) bool {
+	return Len(set1) <= Len(set2) && Forall(set1, func(t T) bool {
+		return Contains(set2, t)
+	})
+}
+
+func (set Set[T]) IsSubsetOf(set2 Set[T]) bool {
+	return IsSubsetOf(set, set2)
+}
+
+/*
+IsSupersetOf returns true if every element of the second Set is present in the first Set.
+Examples:
+IsSupersetOf(Of(1, 2, 3), Of(1, 2)) returns true
+IsSupersetOf(Of(1, 2, 3), Of(1, 4)) returns false
+*/
+func IsSupersetOf[T any](set1 Set[T], set2 Set[T]) bool {
+	return IsSubsetOf(set2, set1)
+}
+
+func (set Set[T]) IsSupersetOf(set2 Set[T]) bool {
+	return IsSupersetOf(set, set2)
+}
+
+/*
+IsDisjoint returns true if the two Sets have no element in common.
+Examples:
+IsDisjoint(Of(1, 2), Of(3, 4)) returns true
+IsDisjoint(Of(1, 2), Of(2, 3)) returns false
+*/
+func IsDisjoint[T any](set1 Set[T], set2 Set[T]) bool {
+	return !AnyMatch(set1, func(t T) bool {
+		return Contains(set2, t)
+	})
+}
+
+func (set Set[T]) IsDisjoint(set2 Set[T]) bool {
+	return IsDisjoint(set, set2)
+}
+
+// maxPowerSetLen is the length of the largest Set accepted by PowerSet.
+const maxPowerSetLen = 30
+
+/*
+PowerSet returns a new Set containing every subset of the input Set, from the empty one to the input Set itself.
+A Set of n elements has 2^n subsets, so PowerSet is only practical for small Sets:
+it panics if the Set has more than 30 elements, whose power set would hold over a billion Sets.
+Example: PowerSet(Of(1, 2)) returns Set[Set[int]]([[],[1],[2],[1,2]])
+*/
+func PowerSet[T any](set Set[T]) Set[Set[T]] {
+	if Len(set) > maxPowerSetLen {
+		panic("set: PowerSet of a Set of more than 30 elements")
+	}
+	subsets := make([]Set[T], 1, 1<<Len(set))
+	subsets[0] = Empty[T]()
+	for _, t := range set.list.ToArray() {
+		for _, subset := range subsets {
+			subsets = append(subsets, pureList(list.Append(subset.list, t)))
+		}
+	}
+	return pure(subsets)
+}
+
+/*
+CartesianProduct returns a new Set containing every pair made of an element of the first Set and an element of the second one.
+Example: CartesianProduct(Of(1, 2), Of("a", "b")) returns Set[Tuple[int, string]]([(1,"a"),(1,"b"),(2,"a"),(2,"b")])
+*/
+func CartesianProduct[A any, B any](set1 Set[A], set2 Set[B]) Set[tuple.Tuple[A, B]] {
+	pairs := make([]tuple.Tuple[A, B], 0, Len(set1)*Len(set2))
+	for _, a := range set1.list.ToArray() {
+		for _, b := range set2.list.ToArray() {
+			pairs = append(pairs, tuple.Pure(a, b))
+		}
+	}
+	return pure(pairs)
+}
+
 /*
 Equals compares two Sets for equality by checking if all elements of the input set are present in the other set.
 Returns true if both sets have the same elements, false otherwise.
@@ -371,12 +809,75 @@ set1.Equals(set2) returns false
 */
 func (set Set[T]) Equals(other interface{}) bool {
 	if os, ok := other.(Set[T]); ok {
-		if Len(set) != Len(set) {
+		if Len(set) != Len(os) {
 			return false
 		}
-		return Fold(set, false, func(result bool, value T) bool {
+		return Fold(set, true, func(result bool, value T) bool {
 			return result && Contains(os, value)
 		})
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the set consistent with Equals, implementing hash.Hashable.
+It does not depend on the order of the elements.
+Example: Of(1, 2).HashCode() == Of(2, 1).HashCode() returns true
+*/
+func (set Set[T]) HashCode() uint64 {
+	sum := Fold(set, uint64(0), func(sum uint64, t T) uint64 {
+		return sum + hash.Of(t)
+	})
+	return hash.Combine(uint64(Len(set)), sum)
+}
+
+/*
+MarshalJSON encodes the set as a plain JSON array.
+Example: json.Marshal(Of(1, 2, 3)) returns []byte("[1,2,3]")
+*/
+func (set Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.list)
+}
+
+/*
+UnmarshalJSON decodes a JSON array into the set, replacing its elements. Duplicate elements are removed.
+Example: json.Unmarshal([]byte("[1,2,2,3]"), &s) sets s to Set[int]([1,2,3])
+*/
+func (set *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*set = Pure(values)
+	return nil
+}
+
+/*
+String returns a readable representation of the set, implementing fmt.Stringer.
+Example: Of(1, 2, 3).String() returns "Set(1, 2, 3)"
+*/
+func (set Set[T]) String() string {
+	return list.MkStringWith(set.list, "Set(", ", ", ")")
+}
+
+/*
+GobEncode encodes the elements of the set with encoding/gob, implementing gob.GobEncoder.
+Example: gob.NewEncoder(w).Encode(Of(1, 2, 3)) writes the set to w
+*/
+func (set Set[T]) GobEncode() ([]byte, error) {
+	return set.list.GobEncode()
+}
+
+/*
+GobDecode decodes elements encoded by GobEncode into the set, replacing its elements, implementing gob.GobDecoder.
+Duplicate elements are removed.
+Example: gob.NewDecoder(r).Decode(&s) reads a set from r into s
+*/
+func (set *Set[T]) GobDecode(data []byte) error {
+	var l list.List[T]
+	if err := l.GobDecode(data); err != nil {
+		return err
+	}
+	*set = Distinct(l)
+	return nil
+}
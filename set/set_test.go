@@ -0,0 +1,161 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Sugther/go-structs/list"
+	"reflect"
+	"testing"
+)
+
+// The zero value tests run the same way with and without the gostructs_strict tag.
+
+func TestZeroSet(t *testing.T) {
+	var zero Set[int]
+	if !zero.IsEmpty() || zero.Len() != 0 || zero.Head().IsPresent() || Contains(zero, 0) {
+		t.Errorf("zero Set is not empty: %v", zero)
+	}
+	if !zero.Equals(Empty[int]()) || zero.HashCode() != Empty[int]().HashCode() {
+		t.Error("zero Set must equal Empty with the same hash code")
+	}
+	if got := zero.Append(1, 1); !got.Equals(Of(1)) {
+		t.Errorf("zero Set Append(1, 1) returns %v, want Set(1)", got)
+	}
+	if got := zero.Pop(); got.IsPresent() {
+		t.Errorf("zero Set Pop returns %v, want None", got)
+	}
+	if data, err := json.Marshal(zero); err != nil || string(data) != "[]" {
+		t.Errorf("json.Marshal(zero Set) returns %s, %v, want []", data, err)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	original := Of(1, 2, 3)
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"nothing", nil, []int{1, 2, 3}},
+		{"new values", []int{4, 5}, []int{1, 2, 3, 4, 5}},
+		{"values already in the set", []int{3, 1}, []int{1, 2, 3}},
+		{"duplicated new values", []int{4, 3, 4, 5, 5}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := original.Append(tt.values...)
+			if !reflect.DeepEqual(got.ToArray(), tt.want) {
+				t.Errorf("Append(%v) returns %v, want %v", tt.values, got.ToArray(), tt.want)
+			}
+		})
+	}
+	if !reflect.DeepEqual(original.ToArray(), []int{1, 2, 3}) {
+		t.Errorf("Append modified the original set: %v", original)
+	}
+}
+
+func TestUnionAll(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want []int
+	}{
+		{"no sets", nil, []int{}},
+		{"empty sets", []Set[int]{Empty[int](), {}}, []int{}},
+		{"one set", []Set[int]{Of(1, 2)}, []int{1, 2}},
+		{"largest first", []Set[int]{Of(1, 2), Of(2, 3), Of(3, 4, 5)}, []int{3, 4, 5, 1, 2}},
+		{"same size in argument order", []Set[int]{Of(1, 2), Of(3, 1)}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnionAll(tt.sets...).ToArray(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnionAll returns %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkUnionAll(b *testing.B) {
+	sets := make([]Set[int], 10)
+	for i := range sets {
+		values := make([]int, 10_000)
+		for j := range values {
+			values[j] = i*5_000 + j
+		}
+		sets[i] = Pure(values)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UnionAll(sets...)
+	}
+}
+
+// account implements Equals while ignoring its label, so that MapChecked cannot index it in a map.
+type account struct {
+	id    int
+	label string
+}
+
+func (a account) Equals(other interface{}) bool {
+	o, ok := other.(account)
+	return ok && o.id == a.id
+}
+
+func TestMapChecked(t *testing.T) {
+	t.Run("no collision", func(t *testing.T) {
+		got := MapChecked(Of(1, 2, 3), func(n int) int { return n * 10 })
+		if !got.IsRight() || !reflect.DeepEqual(got.Right.GetOrElse(Empty[int]()).ToArray(), []int{10, 20, 30}) {
+			t.Errorf("MapChecked returns %v, want Right([10 20 30])", got)
+		}
+	})
+	t.Run("comparable results", func(t *testing.T) {
+		got := MapChecked(Of(1, 2, 3, 4, 5), func(n int) int { return n % 2 })
+		want := []Collision[int, int]{{1, list.Of(1, 3, 5)}, {0, list.Of(2, 4)}}
+		if !got.IsLeft() || !reflect.DeepEqual(got.Left.GetOrElse(list.Empty[Collision[int, int]]()).ToArray(), want) {
+			t.Errorf("MapChecked returns %v, want Left(%v)", got, want)
+		}
+	})
+	t.Run("results with a custom Equals", func(t *testing.T) {
+		got := MapChecked(Of(1, 2, 3), func(n int) account { return account{n % 2, fmt.Sprint(n)} })
+		want := []Collision[int, account]{{account{1, "1"}, list.Of(1, 3)}}
+		if !got.IsLeft() || !reflect.DeepEqual(got.Left.GetOrElse(list.Empty[Collision[int, account]]()).ToArray(), want) {
+			t.Errorf("MapChecked returns %v, want Left(%v)", got, want)
+		}
+	})
+}
+
+func BenchmarkMapChecked(b *testing.B) {
+	values := make([]int, 100_000)
+	for i := range values {
+		values[i] = i
+	}
+	s := Pure(values)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MapChecked(s, func(n int) int { return n * 2 })
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := PowerSet(Of(1, 2, 3))
+	want := Of(Empty[int](), Of(1), Of(2), Of(1, 2), Of(3), Of(1, 3), Of(2, 3), Of(1, 2, 3))
+	if !got.Equals(want) {
+		t.Errorf("PowerSet(Of(1, 2, 3)) returns %v, want %v", got, want)
+	}
+	if got := PowerSet(Empty[int]()); !got.Equals(Of(Empty[int]())) {
+		t.Errorf("PowerSet(Empty) returns %v, want a Set holding the empty Set", got)
+	}
+}
+
+func TestPowerSetTooLarge(t *testing.T) {
+	values := make([]int, 63)
+	for i := range values {
+		values[i] = i
+	}
+	defer func() {
+		if r := recover(); r != "set: PowerSet of a Set of more than 30 elements" {
+			t.Errorf("PowerSet of 63 elements panics with %v", r)
+		}
+	}()
+	PowerSet(Pure(values))
+}
@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package sortedset
+
+import (
+	"github.com/Sugther/go-structs/list"
+	"iter"
+)
+
+/*
+Iter returns an iterator over the elements of the set, in increasing order.
+Example: for v := range Iter(OfOrdered(3, 1, 2)) { fmt.Println(v) } prints 1, 2 and 3
+*/
+func Iter[T any](set SortedSet[T]) iter.Seq[T] {
+	return list.Iter(ToList(set))
+}
+
+func (set SortedSet[T]) Iter() iter.Seq[T] {
+	return Iter(set)
+}
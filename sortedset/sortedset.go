@@ -0,0 +1,249 @@
+package sortedset
+
+import (
+	"github.com/Sugther/go-structs/constraints"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+	"sort"
+)
+
+/*
+SortedSet is a generic struct representing a set of unique values of type T kept in the order of a comparison function.
+Two values are the same element when neither is less than the other.
+It is backed by a sorted slice: Contains, Min, Max and RangeBetween run in O(log n), Append and Remove in O(n).
+Like the other containers, a SortedSet is immutable. Create it with Of, OfOrdered or Empty: the zero value has no order.
+*/
+type SortedSet[T any] struct {
+	values []T
+	less   func(T, T) bool
+}
+
+/*
+Of creates a new SortedSet ordered by the given comparison function and containing the given values.
+Example: Of(func(a int, b int) bool { return a < b }, 3, 1, 2, 3) returns SortedSet[int]([1,2,3])
+*/
+func Of[T any](less func(T, T) bool, values ...T) SortedSet[T] {
+	sorted := make([]T, len(values))
+	copy(sorted, values)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+	unique := sorted[:0]
+	for i, v := range sorted {
+		if i == 0 || less(unique[len(unique)-1], v) {
+			unique = append(unique, v)
+		}
+	}
+	return SortedSet[T]{values: unique[:len(unique):len(unique)], less: less}
+}
+
+/*
+OfOrdered creates a new SortedSet in ascending natural order containing the given values.
+Example: OfOrdered(3, 1, 2, 3) returns SortedSet[int]([1,2,3])
+*/
+func OfOrdered[T constraints.Ordered](values ...T) SortedSet[T] {
+	return Of(func(a T, b T) bool { return a < b }, values...)
+}
+
+/*
+Empty creates a new empty SortedSet ordered by the given comparison function.
+Example: Empty(func(a int, b int) bool { return a < b }) returns SortedSet[int]([])
+*/
+func Empty[T any](less func(T, T) bool) SortedSet[T] {
+	return Of(less)
+}
+
+/*
+Len returns the number of elements of the given set.
+Example: Len(OfOrdered(1, 2, 3)) returns 3
+*/
+func Len[T any](set SortedSet[T]) int {
+	return len(set.values)
+}
+
+func (set SortedSet[T]) Len() int {
+	return Len(set)
+}
+
+/*
+IsEmpty returns true if the given set is empty, false otherwise.
+Examples:
+IsEmpty(OfOrdered(1, 2, 3)) returns false
+IsEmpty(OfOrdered[int]()) returns true
+*/
+func IsEmpty[T any](set SortedSet[T]) bool {
+	return Len(set) == 0
+}
+
+func (set SortedSet[T]) IsEmpty() bool {
+	return IsEmpty(set)
+}
+
+/*
+Contains returns true if the given value is present in the set.
+Examples:
+Contains(OfOrdered(1, 2, 3), 3) returns true
+Contains(OfOrdered(1, 2, 3), 4) returns false
+*/
+func Contains[T any](set SortedSet[T], value T) bool {
+	i := search(set, value)
+	return i < len(set.values) && !set.less(value, set.values[i])
+}
+
+func (set SortedSet[T]) Contains(value T) bool {
+	return Contains(set, value)
+}
+
+/*
+Append returns a new SortedSet with the given values added at their place in the order.
+Example: Append(OfOrdered(1, 3), 2, 3) returns SortedSet[int]([1,2,3])
+*/
+func Append[T any](set SortedSet[T], values ...T) SortedSet[T] {
+	for _, v := range values {
+		i := search(set, v)
+		if i < len(set.values) && !set.less(v, set.values[i]) {
+			continue
+		}
+		inserted := make([]T, 0, len(set.values)+1)
+		inserted = append(inserted, set.values[:i]...)
+		inserted = append(inserted, v)
+		inserted = append(inserted, set.values[i:]...)
+		set = SortedSet[T]{values: inserted, less: set.less}
+	}
+	return set
+}
+
+func (set SortedSet[T]) Append(values ...T) SortedSet[T] {
+	return Append(set, values...)
+}
+
+/*
+Remove returns a new SortedSet without the given values.
+Example: Remove(OfOrdered(1, 2, 3), 2, 4) returns SortedSet[int]([1,3])
+*/
+func Remove[T any](set SortedSet[T], values ...T) SortedSet[T] {
+	for _, v := range values {
+		i := search(set, v)
+		if i == len(set.values) || set.less(v, set.values[i]) {
+			continue
+		}
+		removed := make([]T, 0, len(set.values)-1)
+		removed = append(removed, set.values[:i]...)
+		removed = append(removed, set.values[i+1:]...)
+		set = SortedSet[T]{values: removed, less: set.less}
+	}
+	return set
+}
+
+func (set SortedSet[T]) Remove(values ...T) SortedSet[T] {
+	return Remove(set, values...)
+}
+
+/*
+Min returns the smallest element of the set wrapped in an Option, or an empty Option if the set is empty.
+Examples:
+Min(OfOrdered(2, 1, 3)) returns Option[int](1)
+Min(OfOrdered[int]()) returns Option[int]{isPresent: false}
+*/
+func Min[T any](set SortedSet[T]) option.Option[T] {
+	return list.Head(ToList(set))
+}
+
+func (set SortedSet[T]) Min() option.Option[T] {
+	return Min(set)
+}
+
+/*
+Max returns the largest element of the set wrapped in an Option, or an empty Option if the set is empty.
+Examples:
+Max(OfOrdered(2, 1, 3)) returns Option[int](3)
+Max(OfOrdered[int]()) returns Option[int]{isPresent: false}
+*/
+func Max[T any](set SortedSet[T]) option.Option[T] {
+	return list.Last(ToList(set))
+}
+
+func (set SortedSet[T]) Max() option.Option[T] {
+	return Max(set)
+}
+
+/*
+RangeBetween returns a new SortedSet with the elements greater than or equal to lo and less than or equal to hi.
+The result shares the storage of the original set.
+Examples:
+RangeBetween(OfOrdered(1, 2, 3, 4, 5), 2, 4) returns SortedSet[int]([2,3,4])
+RangeBetween(OfOrdered(1, 2, 3, 4, 5), 4, 2) returns SortedSet[int]([])
+*/
+func RangeBetween[T any](set SortedSet[T], lo T, hi T) SortedSet[T] {
+	start := search(set, lo)
+	end := sort.Search(len(set.values), func(i int) bool {
+		return set.less(hi, set.values[i])
+	})
+	if end < start {
+		end = start
+	}
+	return SortedSet[T]{values: set.values[start:end:end], less: set.less}
+}
+
+func (set SortedSet[T]) RangeBetween(lo T, hi T) SortedSet[T] {
+	return RangeBetween(set, lo, hi)
+}
+
+/*
+Fold applies a function to the elements of the set in order in a cumulative way, starting from the given root value.
+Example: Fold(OfOrdered(1, 2, 3), "", func(s string, n int) string { return s + strconv.Itoa(n) }) returns "123"
+*/
+func Fold[T any, R any](set SortedSet[T], root R, f func(R, T) R) R {
+	return list.Fold(ToList(set), root, f)
+}
+
+/*
+ForEach applies a function to each element of the set in order.
+Example: ForEach(OfOrdered(2, 1), func(n int) { fmt.Println(n) }) prints 1 then 2
+*/
+func ForEach[T any](set SortedSet[T], f func(T)) {
+	list.ForEachIndexed(ToList(set), func(_ int, t T) { f(t) })
+}
+
+func (set SortedSet[T]) ForEach(f func(T)) {
+	ForEach(set, f)
+}
+
+/*
+ToArray returns the elements of the set in order as a new slice.
+Example: ToArray(OfOrdered(3, 1, 2)) returns []int{1, 2, 3}
+*/
+func ToArray[T any](set SortedSet[T]) []T {
+	return ToList(set).Copy().ToArray()
+}
+
+func (set SortedSet[T]) ToArray() []T {
+	return ToArray(set)
+}
+
+/*
+ToList returns the elements of the set in order as a list.List.
+Example: ToList(OfOrdered(3, 1, 2)) returns List[int]([1,2,3])
+*/
+func ToList[T any](set SortedSet[T]) list.List[T] {
+	return list.Pure(set.values)
+}
+
+func (set SortedSet[T]) ToList() list.List[T] {
+	return ToList(set)
+}
+
+/*
+String returns a readable representation of the set, implementing fmt.Stringer.
+Example: OfOrdered(3, 1, 2).String() returns "SortedSet(1, 2, 3)"
+*/
+func (set SortedSet[T]) String() string {
+	return list.MkStringWith(ToList(set), "SortedSet(", ", ", ")")
+}
+
+// search returns the index of the first element that is not less than value.
+func search[T any](set SortedSet[T], value T) int {
+	return sort.Search(len(set.values), func(i int) bool {
+		return !set.less(set.values[i], value)
+	})
+}
@@ -0,0 +1,109 @@
+package structsfuncs
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type optional interface {
+	IsPresent() bool
+}
+
+type alternative interface {
+	IsRight() bool
+}
+
+/*
+FuncMap returns the functions making Option, Either, List and Set usable inside text/template and html/template.
+The returned map can be passed to the Funcs method of both template packages.
+Examples:
+{{if isPresent .Name}}{{get .Name}}{{end}}
+{{getOrElse .Name "anonymous"}}
+{{if isRight .Result}}{{right .Result}}{{else}}{{left .Result}}{{end}}
+{{range toSlice .Items}}{{.}}{{end}}
+*/
+func FuncMap() map[string]any {
+	return map[string]any{
+		"isPresent": isPresent,
+		"isEmpty":   isEmpty,
+		"get":       get,
+		"getOrElse": getOrElse,
+		"isRight":   isRight,
+		"isLeft":    isLeft,
+		"right":     right,
+		"left":      left,
+		"toSlice":   toSlice,
+	}
+}
+
+func isPresent(opt any) (bool, error) {
+	o, ok := opt.(optional)
+	if !ok {
+		return false, fmt.Errorf("isPresent: %T is not an Option", opt)
+	}
+	return o.IsPresent(), nil
+}
+
+func isEmpty(opt any) (bool, error) {
+	present, err := isPresent(opt)
+	return !present, err
+}
+
+func get(opt any) (any, error) {
+	present, err := isPresent(opt)
+	if err != nil {
+		return nil, err
+	}
+	if !present {
+		return nil, fmt.Errorf("get: %T is empty", opt)
+	}
+	return call(opt, "Get")
+}
+
+func getOrElse(opt any, defaultValue any) (any, error) {
+	present, err := isPresent(opt)
+	if err != nil || !present {
+		return defaultValue, err
+	}
+	return call(opt, "Get")
+}
+
+func isRight(either any) (bool, error) {
+	e, ok := either.(alternative)
+	if !ok {
+		return false, fmt.Errorf("isRight: %T is not an Either", either)
+	}
+	return e.IsRight(), nil
+}
+
+func isLeft(either any) (bool, error) {
+	r, err := isRight(either)
+	return !r, err
+}
+
+func right(either any) (any, error) {
+	return side(either, "Right")
+}
+
+func left(either any) (any, error) {
+	return side(either, "Left")
+}
+
+func side(either any, name string) (any, error) {
+	if _, ok := either.(alternative); !ok {
+		return nil, fmt.Errorf("%T is not an Either", either)
+	}
+	return get(reflect.ValueOf(either).FieldByName(name).Interface())
+}
+
+func toSlice(collection any) (any, error) {
+	return call(collection, "ToArray")
+}
+
+func call(value any, method string) (any, error) {
+	m := reflect.ValueOf(value).MethodByName(method)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("%T has no %s method", value, method)
+	}
+	return m.Call(nil)[0].Interface(), nil
+}
@@ -0,0 +1,14 @@
+/*
+Command structsvet reports unguarded calls to Option.Get and List.Tail.
+It can run on its own, as in `structsvet ./...`, or through go vet with `go vet -vettool=$(which structsvet) ./...`.
+*/
+package main
+
+import (
+	"github.com/Sugther/go-structs/structsvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(structsvet.Analyzer)
+}
@@ -0,0 +1,258 @@
+/*
+Package structsvet provides an analysis.Analyzer reporting calls to the unsafe accessors of go-structs
+that are not guarded by a check of the same value:
+Option.Get and option.Get (including Either.Right.Get and Either.Left.Get), and List.Tail and list.Tail.
+
+A call is considered guarded when it is in the body of an if statement whose condition requires the check,
+in the else branch of one whose condition requires the opposite check, on the right side of a && or ||
+operator whose left side performs the check, or after an if statement that performs the opposite check and
+always returns, panics, breaks or continues. Values are matched by their source expression, so reassigning
+a value between the check and the call is not detected.
+
+Recognized checks are IsPresent/IsEmpty for Options, IsRight/IsLeft for Eithers and NonEmpty/IsEmpty for Lists,
+called either as methods or as package functions.
+*/
+package structsvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	eitherPath = "github.com/Sugther/go-structs/either"
+	listPath   = "github.com/Sugther/go-structs/list"
+	optionPath = "github.com/Sugther/go-structs/option"
+)
+
+/*
+Analyzer reports unguarded calls to Option.Get and List.Tail.
+*/
+var Analyzer = &analysis.Analyzer{
+	Name:     "structsvet",
+	Doc:      "report Option.Get and List.Tail calls that are not guarded by IsPresent, IsRight or NonEmpty checks",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// check is a guard call on a value, identified by the source expression of the value and the name of the guard.
+type check struct {
+	value string
+	name  string
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := n.(*ast.CallExpr)
+		name, receiver, ok := accessor(pass, call)
+		if !ok {
+			return true
+		}
+		positive, negative := guards(receiver)
+		if !isGuarded(pass, stack, positive, negative) {
+			pass.Reportf(call.Pos(), "%s called on %s without checking it first", name, types.ExprString(receiver))
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// accessor reports whether call is an unsafe accessor call, and returns its name and the value it is called on.
+func accessor(pass *analysis.Pass, call *ast.CallExpr) (string, ast.Expr, bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return "", nil, false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return "", nil, false
+	}
+	path, name := fn.Pkg().Path(), fn.Name()
+	if !(path == optionPath && name == "Get") && !(path == listPath && name == "Tail") {
+		return "", nil, false
+	}
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return receiverType(path) + "." + name, ast.Unparen(sel.X), true
+	}
+	if len(call.Args) != 1 {
+		return "", nil, false
+	}
+	return fn.Pkg().Name() + "." + name, ast.Unparen(call.Args[0]), true
+}
+
+func receiverType(path string) string {
+	if path == listPath {
+		return "List"
+	}
+	return "Option"
+}
+
+// guards returns the checks proving that receiver is safe to access, and those proving that it is not.
+func guards(receiver ast.Expr) (map[check]bool, map[check]bool) {
+	value := types.ExprString(receiver)
+	positive := map[check]bool{{value, "IsPresent"}: true, {value, "NonEmpty"}: true}
+	negative := map[check]bool{{value, "IsEmpty"}: true}
+	if sel, ok := receiver.(*ast.SelectorExpr); ok {
+		either := types.ExprString(sel.X)
+		switch sel.Sel.Name {
+		case "Right":
+			positive[check{either, "IsRight"}] = true
+			negative[check{either, "IsLeft"}] = true
+		case "Left":
+			positive[check{either, "IsLeft"}] = true
+			negative[check{either, "IsRight"}] = true
+		}
+	}
+	return positive, negative
+}
+
+// isGuarded walks the enclosing nodes of a call, from the innermost one, looking for a check guarding it.
+func isGuarded(pass *analysis.Pass, stack []ast.Node, positive map[check]bool, negative map[check]bool) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch parent := stack[i].(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		case *ast.IfStmt:
+			if child == parent.Body && requires(pass, parent.Cond, positive, negative) {
+				return true
+			}
+			if child == parent.Else && excludes(pass, parent.Cond, positive, negative) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != parent.Y {
+				continue
+			}
+			if parent.Op == token.LAND && requires(pass, parent.X, positive, negative) {
+				return true
+			}
+			if parent.Op == token.LOR && excludes(pass, parent.X, positive, negative) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if precededByGuard(pass, parent.List, child, positive, negative) {
+				return true
+			}
+		case *ast.CaseClause:
+			if precededByGuard(pass, parent.Body, child, positive, negative) {
+				return true
+			}
+		case *ast.CommClause:
+			if precededByGuard(pass, parent.Body, child, positive, negative) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// precededByGuard reports whether child is one of stmts and a statement before it is an if statement that performs
+// the opposite check and always terminates, so that the check holds when child runs.
+func precededByGuard(pass *analysis.Pass, stmts []ast.Stmt, child ast.Node, positive map[check]bool, negative map[check]bool) bool {
+	guarded := false
+	for _, stmt := range stmts {
+		if stmt == child {
+			return guarded
+		}
+		if ifStmt, ok := stmt.(*ast.IfStmt); ok && ifStmt.Else == nil && terminates(ifStmt.Body) &&
+			excludes(pass, ifStmt.Cond, positive, negative) {
+			guarded = true
+		}
+	}
+	return false
+}
+
+// requires reports whether expr being true implies that one of the wanted checks holds.
+// The opposite checks are those whose failure implies that one of the wanted checks holds.
+func requires(pass *analysis.Pass, expr ast.Expr, wanted map[check]bool, opposite map[check]bool) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.LAND {
+			return requires(pass, e.X, wanted, opposite) || requires(pass, e.Y, wanted, opposite)
+		}
+		if e.Op == token.LOR {
+			return requires(pass, e.X, wanted, opposite) && requires(pass, e.Y, wanted, opposite)
+		}
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			return excludes(pass, e.X, wanted, opposite)
+		}
+	case *ast.CallExpr:
+		c, ok := guardCheck(pass, e)
+		return ok && wanted[c]
+	}
+	return false
+}
+
+// excludes reports whether expr being false implies that one of the wanted checks holds.
+func excludes(pass *analysis.Pass, expr ast.Expr, wanted map[check]bool, opposite map[check]bool) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.LOR {
+			return excludes(pass, e.X, wanted, opposite) || excludes(pass, e.Y, wanted, opposite)
+		}
+		if e.Op == token.LAND {
+			return excludes(pass, e.X, wanted, opposite) && excludes(pass, e.Y, wanted, opposite)
+		}
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			return requires(pass, e.X, wanted, opposite)
+		}
+	case *ast.CallExpr:
+		c, ok := guardCheck(pass, e)
+		return ok && opposite[c]
+	}
+	return false
+}
+
+// guardCheck returns the check performed by call if it is a method or function of the either, list or option packages.
+func guardCheck(pass *analysis.Pass, call *ast.CallExpr) (check, bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return check{}, false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return check{}, false
+	}
+	switch fn.Pkg().Path() {
+	case eitherPath, listPath, optionPath:
+	default:
+		return check{}, false
+	}
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return check{types.ExprString(ast.Unparen(sel.X)), fn.Name()}, true
+	}
+	if len(call.Args) != 1 {
+		return check{}, false
+	}
+	return check{types.ExprString(ast.Unparen(call.Args[0])), fn.Name()}, true
+}
+
+// terminates reports whether the block always ends with a return, a panic, a break, a continue or a goto.
+func terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch last := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		return ok && ident.Name == "panic"
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+package structsvet_test
+
+import (
+	"github.com/Sugther/go-structs/structsvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), structsvet.Analyzer, "a")
+}
@@ -0,0 +1,148 @@
+package a
+
+import (
+	"github.com/Sugther/go-structs/either"
+	"github.com/Sugther/go-structs/list"
+	"github.com/Sugther/go-structs/option"
+)
+
+func unguarded(o option.Option[int], l list.List[int]) {
+	o.Get()          // want `Option.Get called on o without checking it first`
+	option.Get(o)    // want `option.Get called on o without checking it first`
+	l.Tail()         // want `List.Tail called on l without checking it first`
+	list.Tail(l)     // want `list.Tail called on l without checking it first`
+	if o.IsEmpty() { // the call below is in the wrong branch
+		o.Get() // want `Option.Get called on o without checking it first`
+	}
+}
+
+func ifBody(o option.Option[int], l list.List[int]) {
+	if o.IsPresent() {
+		o.Get()
+	}
+	if option.IsPresent(o) {
+		option.Get(o)
+	}
+	if !o.IsEmpty() {
+		o.Get()
+	}
+	if l.NonEmpty() {
+		l.Tail()
+	}
+	if list.NonEmpty(l) {
+		list.Tail(l)
+	}
+}
+
+func elseBranch(o option.Option[int], l list.List[int]) {
+	if o.IsEmpty() {
+		return
+	} else {
+		o.Get()
+	}
+	if l.IsEmpty() {
+	} else {
+		l.Tail()
+	}
+	if o.IsPresent() {
+	} else {
+		o.Get() // want `Option.Get called on o without checking it first`
+	}
+}
+
+func binaryOperators(o option.Option[int], p option.Option[int]) bool {
+	_ = o.IsPresent() && o.Get() > 0
+	_ = o.IsEmpty() || o.Get() > 0
+	_ = o.IsPresent() || o.Get() > 0 // want `Option.Get called on o without checking it first`
+	_ = p.IsPresent() && o.Get() > 0 // want `Option.Get called on o without checking it first`
+	if o.IsPresent() && p.IsPresent() {
+		return o.Get() > p.Get()
+	}
+	if o.IsPresent() || p.IsPresent() {
+		return o.Get() > 0 // want `Option.Get called on o without checking it first`
+	}
+	return false
+}
+
+func earlyReturn(o option.Option[int], l list.List[int]) int {
+	if o.IsEmpty() {
+		return 0
+	}
+	if l.IsEmpty() {
+		panic("empty")
+	}
+	l.Tail()
+	return o.Get()
+}
+
+func earlyReturnNotTerminating(o option.Option[int]) int {
+	if o.IsEmpty() {
+		o = option.Pure(0)
+	}
+	return o.Get() // want `Option.Get called on o without checking it first`
+}
+
+func loop(options []option.Option[int]) int {
+	sum := 0
+	for _, o := range options {
+		if !o.IsPresent() {
+			continue
+		}
+		sum += o.Get()
+	}
+	return sum
+}
+
+func eitherRight(e either.Either[error, int]) int {
+	if e.IsRight() {
+		return e.Right.Get()
+	}
+	if either.IsLeft(e) {
+		return 0
+	}
+	return e.Right.Get()
+}
+
+func eitherLeft(e either.Either[error, int]) error {
+	if e.IsLeft() {
+		return e.Left.Get()
+	}
+	return e.Left.Get() // want `Option.Get called on e.Left without checking it first`
+}
+
+func eitherRightUnguarded(e either.Either[error, int]) int {
+	return e.Right.Get() // want `Option.Get called on e.Right without checking it first`
+}
+
+func switchCase(o option.Option[int], n int) int {
+	switch n {
+	case 0:
+		if o.IsEmpty() {
+			return 0
+		}
+		return o.Get()
+	default:
+		return o.Get() // want `Option.Get called on o without checking it first`
+	}
+}
+
+func selectCase(o option.Option[int], c chan int) {
+	select {
+	case v := <-c:
+		if o.IsEmpty() {
+			return
+		}
+		c <- v + o.Get()
+	default:
+		c <- o.Get() // want `Option.Get called on o without checking it first`
+	}
+}
+
+func closure(o option.Option[int]) func() int {
+	if o.IsPresent() {
+		return func() int {
+			return o.Get() // want `Option.Get called on o without checking it first`
+		}
+	}
+	return nil
+}
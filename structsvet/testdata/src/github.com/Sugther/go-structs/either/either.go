@@ -0,0 +1,15 @@
+// Package either is a stub of github.com/Sugther/go-structs/either for the analyzer tests.
+package either
+
+import "github.com/Sugther/go-structs/option"
+
+type Either[L any, R any] struct {
+	Right option.Option[R]
+	Left  option.Option[L]
+}
+
+func IsRight[L any, R any](either Either[L, R]) bool { return either.Right.IsPresent() }
+func IsLeft[L any, R any](either Either[L, R]) bool  { return either.Left.IsPresent() }
+
+func (either Either[L, R]) IsRight() bool { return IsRight(either) }
+func (either Either[L, R]) IsLeft() bool  { return IsLeft(either) }
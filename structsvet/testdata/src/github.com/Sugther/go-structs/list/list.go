@@ -0,0 +1,14 @@
+// Package list is a stub of github.com/Sugther/go-structs/list for the analyzer tests.
+package list
+
+type List[T any] struct {
+	values []T
+}
+
+func Tail[T any](list List[T]) List[T]  { return List[T]{list.values[1:]} }
+func NonEmpty[T any](list List[T]) bool { return len(list.values) > 0 }
+func IsEmpty[T any](list List[T]) bool  { return len(list.values) == 0 }
+
+func (list List[T]) Tail() List[T]  { return Tail(list) }
+func (list List[T]) NonEmpty() bool { return NonEmpty(list) }
+func (list List[T]) IsEmpty() bool  { return IsEmpty(list) }
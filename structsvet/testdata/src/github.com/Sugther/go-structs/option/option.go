@@ -0,0 +1,17 @@
+// Package option is a stub of github.com/Sugther/go-structs/option for the analyzer tests.
+package option
+
+type Option[T any] struct {
+	value     T
+	isPresent bool
+}
+
+func Pure[T any](value T) Option[T] { return Option[T]{value, true} }
+
+func Get[T any](option Option[T]) T          { return option.value }
+func IsPresent[T any](option Option[T]) bool { return option.isPresent }
+func IsEmpty[T any](option Option[T]) bool   { return !option.isPresent }
+
+func (option Option[T]) Get() T          { return Get(option) }
+func (option Option[T]) IsPresent() bool { return IsPresent(option) }
+func (option Option[T]) IsEmpty() bool   { return IsEmpty(option) }
@@ -0,0 +1,22 @@
+//go:build go1.23
+
+package try
+
+import "iter"
+
+/*
+Iter returns an iterator over the successful computation result of a Try value:
+it yields the result once if the Try is a success, and nothing otherwise. It does not run the finally function.
+Examples:
+for v := range Iter(Success(1)) { fmt.Println(v) } prints 1
+for v := range Iter(Fail[int](error)) { fmt.Println(v) } does not print
+*/
+func Iter[T any](try Try[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ForEach(try, func(t T) { yield(t) })
+	}
+}
+
+func (try Try[T]) Iter() iter.Seq[T] {
+	return Iter(try)
+}
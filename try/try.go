@@ -1,15 +1,19 @@
 package try
 
 import (
+	"fmt"
 	"github.com/Sugther/go-structs/either"
 	"github.com/Sugther/go-structs/equal"
 	"github.com/Sugther/go-structs/option"
+	"sync"
 )
 
 /*
 Try is a container for a value of type T that may or may not have been successfully computed.
 It contains an Either value with Left holding an error and Right holding a value of type T,
 and a finallyFunction that is executed when the computation is done.
+The zero value is neither a success nor a failure and is not valid: build Trys with Success, Fail or Pure,
+and use IsValid to detect a zero Try, for instance in a struct field left uninitialized.
 */
 type Try[T any] struct {
 	either          either.Either[error, T]
@@ -81,6 +85,22 @@ func (try Try[T]) IsFail() bool {
 	return IsFail(try)
 }
 
+/*
+IsValid checks if a Try value is either a success or a failure, as the Try values built by Success, Fail and Pure are.
+The zero Try is not valid: it is neither, and it is handled as a failure with a nil error by Fold and the functions built on it,
+such as Map and FlatMap, with or without the `gostructs_strict` tag.
+Examples:
+IsValid(Success[int](42)) returns true
+IsValid(Try[int]{}) returns false
+*/
+func IsValid[T any](try Try[T]) bool {
+	return either.IsValid(try.either)
+}
+
+func (try Try[T]) IsValid() bool {
+	return IsValid(try)
+}
+
 /*
 GetOrElse returns the successful computation result of a Try value if it exists,
 or a default value if the Try value contains a failed computation.
@@ -98,29 +118,41 @@ func (try Try[T]) GetOrElse(defaultValue T) T {
 
 /*
 Finally registers a function to be executed when the Try value is finalized by calling the End method.
-The function f takes the successful computation result of type T as input.
-The finallyFunction is stored in the Try value and is executed when End is called.
+The function runs exactly once, whether the Try value contains a successful computation or a failed one,
+even if End is called several times or on copies of the Try value.
+Functions registered later run first, like deferred calls, and the functions inherited through FlatMap,
+Map or FlatMapFail run after those registered on the resulting Try value.
+Example:
+Success[int](42).Finally(closeFile).FlatMap(parse).Finally(closeConn).End() runs closeConn, then closeFile.
 */
-func Finally[T any](try Try[T], f func(T)) Try[T] {
+func Finally[T any](try Try[T], f func()) Try[T] {
 	return Try[T]{
 		either: try.either,
-		finallyFunction: func() {
-			either.ForEach(try.either, f)
+		finallyFunction: once(func() {
+			f()
 			End(try)
-		},
+		}),
 	}
 }
 
-func (try Try[T]) Finally(f func(T)) Try[T] {
+func (try Try[T]) Finally(f func()) Try[T] {
 	return Finally(try, f)
 }
 
+func once(f func()) func() {
+	var o sync.Once
+	return func() { o.Do(f) }
+}
+
 /*
 End executes the finallyFunction of a Try value and returns the Try value without the finallyFunction.
-Example: End(Finally(Success[int](42), func(v int) { fmt.Println("Ended:", v) })) returns Success[int](42)
+A Try value without finallyFunction, such as the zero Try, is returned as is.
+Example: End(Finally(Success[int](42), func() { fmt.Println("Ended") })) prints "Ended" and returns Success[int](42)
 */
 func End[T any](try Try[T]) Try[T] {
-	try.finallyFunction()
+	if try.finallyFunction != nil {
+		try.finallyFunction()
+	}
 	return Try[T]{
 		either:          try.either,
 		finallyFunction: func() {},
@@ -143,21 +175,22 @@ func Fold[T any, R any](try Try[T], fFail func(error) R, fSuccess func(T) R) R {
 
 /*
 FlatMap applies the function f to the successful computation result of a Try value, returning a new Try value of a different type.
+Ending the resulting Try value runs the finally functions of the Try value returned by f, then those of the original Try value.
 Examples:
 FlatMap(Success[int](2), func(value int) Try[string] { return Success[strconv.Itoa(value * 2)] }) returns Success[string]("4")
 FlatMap(Fail[int](error), func(value int) Try[string] { return Success[strconv.Itoa(value * 2)] }) returns Fail[string](error)
 */
 func FlatMap[T any, R any](try Try[T], f func(T) Try[R]) Try[R] {
 	return Fold(try, func(err error) Try[R] {
-		return Finally(Fail[R](err), func(ignored R) { try.finallyFunction() })
+		return Finally(Fail[R](err), func() { End(try) })
 	}, func(t T) Try[R] {
 		r := f(t)
 		return Try[R]{
 			either: r.either,
-			finallyFunction: func() {
+			finallyFunction: once(func() {
 				End(r)
 				End(try)
-			},
+			}),
 		}
 	})
 }
@@ -174,6 +207,24 @@ func Map[T any, R any](try Try[T], f func(T) R) Try[R] {
 	})
 }
 
+/*
+Ap applies the function contained in the first Try to the successful computation result of the second one.
+If the first Try is a failure, its error is returned; otherwise, if the second one is a failure, its error is returned.
+Ending the resulting Try value runs the finally functions of both Try values.
+Examples:
+Ap(Success(func(x int) int { return x * 2 }), Success(21)) returns Success[int](42)
+Ap(Fail[func(int) int](error), Success(21)) returns Fail[int](error)
+*/
+func Ap[T any, R any](f Try[func(T) R], try Try[T]) Try[R] {
+	result := FlatMap(f, func(g func(T) R) Try[R] {
+		return Map(try, g)
+	})
+	if IsFail(f) {
+		return Finally(result, func() { End(try) })
+	}
+	return result
+}
+
 /*
 ForEach applies the function f to the successful computation result of a Try value.
 Examples:
@@ -214,10 +265,10 @@ func FlatMapFail[T any](try Try[T], f func(error) Try[T]) Try[T] {
 		r := f(err)
 		return Try[T]{
 			either: r.either,
-			finallyFunction: func() {
+			finallyFunction: once(func() {
 				End(r)
 				End(try)
-			},
+			}),
 		}
 	}, func(t T) Try[T] { return try })
 }
@@ -280,9 +331,51 @@ func (try Try[T]) ToEither() either.Either[error, T] {
 	return try.either
 }
 
+/*
+TraverseEither applies f to the Right value of an Either and moves the resulting Try outside of the Either.
+If f returns a failed Try, the result fails with the same error. A Left value is kept as is inside a successful Try.
+Examples:
+TraverseEither(either.Right[string, string]("42"), func(s string) Try[int] { return Pure(strconv.Atoi(s)) }) returns Success(Right(42))
+TraverseEither(either.Right[string, string]("x"), func(s string) Try[int] { return Pure(strconv.Atoi(s)) }) returns Fail(error)
+TraverseEither(either.Left[string, string]("missing"), func(s string) Try[int] { return Pure(strconv.Atoi(s)) }) returns Success(Left("missing"))
+*/
+func TraverseEither[L any, R any, T any](e either.Either[L, R], f func(R) Try[T]) Try[either.Either[L, T]] {
+	return either.Fold(e, func(l L) Try[either.Either[L, T]] {
+		return Success(either.Left[L, T](l))
+	}, func(r R) Try[either.Either[L, T]] {
+		return Map(f(r), either.Right[L, T])
+	})
+}
+
 func (try Try[T]) Equals(other interface{}) bool {
 	if ot, ok := other.(Try[T]); ok {
 		return equal.Equals(ot.either, try.either)
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the Try consistent with Equals, implementing hash.Hashable.
+Example: Success(42).HashCode() == Success(42).HashCode() returns true
+*/
+func (try Try[T]) HashCode() uint64 {
+	return try.either.HashCode()
+}
+
+/*
+String returns a readable representation of the Try, implementing fmt.Stringer.
+Examples:
+Success(42).String() returns "Success(42)"
+Fail[int](errors.New("error")).String() returns "Fail(error)"
+Try[int]{}.String() returns "Invalid"
+*/
+func (try Try[T]) String() string {
+	if !IsValid(try) {
+		return "Invalid"
+	}
+	return Fold(try, func(err error) string {
+		return fmt.Sprintf("Fail(%v)", err)
+	}, func(t T) string {
+		return fmt.Sprintf("Success(%v)", t)
+	})
+}
@@ -0,0 +1,135 @@
+package try
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// The zero value tests run the same way with and without the gostructs_strict tag.
+
+func TestZeroTry(t *testing.T) {
+	var zero Try[int]
+	if zero.IsValid() || zero.IsSuccess() || zero.IsFail() {
+		t.Errorf("zero Try: IsValid %v, IsSuccess %v, IsFail %v, want all false", zero.IsValid(), zero.IsSuccess(), zero.IsFail())
+	}
+	if got := zero.String(); got != "Invalid" {
+		t.Errorf("zero Try String returns %q, want %q", got, "Invalid")
+	}
+	ended := End(zero)
+	if ended.IsValid() {
+		t.Errorf("End(zero Try) returns %v, want an invalid Try", ended)
+	}
+	mapped := Map(zero, func(n int) int { t.Error("Map(zero Try) called f"); return n }).End()
+	if !mapped.IsFail() {
+		t.Errorf("Map(zero Try) returns %v, want a failure", mapped)
+	}
+	Fold(zero, func(err error) int {
+		if err != nil {
+			t.Errorf("Fold(zero Try) gives error %v, want nil", err)
+		}
+		return 0
+	}, func(int) int { t.Error("Fold(zero Try) called fSuccess"); return 0 })
+	FlatMap(zero, func(n int) Try[int] { return Success(n) }).End()
+	if got := GetOrElse(zero, 7); got != 7 {
+		t.Errorf("GetOrElse(zero Try, 7) returns %d, want 7", got)
+	}
+}
+
+// recorder records the names of the finally functions in the order they run.
+type recorder []string
+
+func (r *recorder) finally(name string) func() {
+	return func() { *r = append(*r, name) }
+}
+
+func (r *recorder) expect(t *testing.T, want ...string) {
+	t.Helper()
+	if !reflect.DeepEqual([]string(*r), want) {
+		t.Errorf("finally functions ran as %v, want %v", []string(*r), want)
+	}
+}
+
+func TestFinally(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name string
+		try  func(r *recorder) Try[int]
+		want []string
+	}{
+		{"success", func(r *recorder) Try[int] {
+			return Success(1).Finally(r.finally("a"))
+		}, []string{"a"}},
+		{"fail", func(r *recorder) Try[int] {
+			return Fail[int](errFailed).Finally(r.finally("a"))
+		}, []string{"a"}},
+		{"registered later runs first", func(r *recorder) Try[int] {
+			return Success(1).Finally(r.finally("a")).Finally(r.finally("b"))
+		}, []string{"b", "a"}},
+		{"FlatMap runs inner before outer", func(r *recorder) Try[int] {
+			return FlatMap(Success(1).Finally(r.finally("outer")), func(n int) Try[int] {
+				return Success(n).Finally(r.finally("inner"))
+			}).Finally(r.finally("result"))
+		}, []string{"result", "inner", "outer"}},
+		{"FlatMap on a failure", func(r *recorder) Try[int] {
+			return FlatMap(Fail[int](errFailed).Finally(r.finally("outer")), func(n int) Try[int] {
+				t.Error("FlatMap called f on a failure")
+				return Success(n)
+			}).Finally(r.finally("result"))
+		}, []string{"result", "outer"}},
+		{"FlatMapFail runs inner before outer", func(r *recorder) Try[int] {
+			return FlatMapFail(Fail[int](errFailed).Finally(r.finally("outer")), func(error) Try[int] {
+				return Success(0).Finally(r.finally("inner"))
+			}).Finally(r.finally("result"))
+		}, []string{"result", "inner", "outer"}},
+		{"Ap with a failing function", func(r *recorder) Try[int] {
+			f := Fail[func(int) int](errFailed).Finally(r.finally("f"))
+			return Ap(f, Success(1).Finally(r.finally("argument")))
+		}, []string{"argument", "f"}},
+		{"Ap with a failing argument", func(r *recorder) Try[int] {
+			f := Success(func(n int) int { return n }).Finally(r.finally("f"))
+			return Ap(f, Fail[int](errFailed).Finally(r.finally("argument")))
+		}, []string{"argument", "f"}},
+		{"Ap with successes", func(r *recorder) Try[int] {
+			f := Success(func(n int) int { return n }).Finally(r.finally("f"))
+			return Ap(f, Success(1).Finally(r.finally("argument")))
+		}, []string{"argument", "f"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r recorder
+			try := tt.try(&r)
+			r.expect(t)
+			try.End()
+			r.expect(t, tt.want...)
+		})
+	}
+}
+
+func TestFinallyRunsOnce(t *testing.T) {
+	t.Run("End called twice", func(t *testing.T) {
+		var r recorder
+		try := Fail[int](errors.New("failed")).Finally(r.finally("a"))
+		ended := try.End()
+		ended.End()
+		try.End()
+		r.expect(t, "a")
+	})
+	t.Run("End on copies", func(t *testing.T) {
+		var r recorder
+		try := Success(1).Finally(r.finally("a")).Finally(r.finally("b"))
+		copied := try
+		try.End()
+		copied.End()
+		r.expect(t, "b", "a")
+	})
+	t.Run("shared by two FlatMaps", func(t *testing.T) {
+		var r recorder
+		try := Success(1).Finally(r.finally("a"))
+		first := FlatMap(try, func(n int) Try[int] { return Success(n) })
+		second := Map(try, func(n int) int { return n })
+		first.End()
+		second.End()
+		r.expect(t, "a")
+	})
+}
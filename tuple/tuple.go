@@ -1,6 +1,12 @@
 package tuple
 
-import "github.com/Sugther/go-structs/equal"
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"github.com/Sugther/go-structs/equal"
+	"github.com/Sugther/go-structs/hash"
+)
 
 /*
 Tuple is a generic struct that represents a pair of values with types T1 and T2
@@ -155,3 +161,52 @@ func (tuple Tuple[T1, T2]) Equals(other interface{}) bool {
 	}
 	return false
 }
+
+/*
+HashCode returns a hash code of the Tuple consistent with Equals, implementing hash.Hashable.
+Example: Pure(1, "hello").HashCode() == Pure(1, "hello").HashCode() returns true
+*/
+func (tuple Tuple[T1, T2]) HashCode() uint64 {
+	return hash.Combine(hash.Of(tuple._1), hash.Of(tuple._2))
+}
+
+/*
+String returns a readable representation of the Tuple, implementing fmt.Stringer.
+Example: Pure(1, "hello").String() returns "Tuple(1, hello)"
+*/
+func (tuple Tuple[T1, T2]) String() string {
+	return fmt.Sprintf("Tuple(%v, %v)", tuple._1, tuple._2)
+}
+
+/*
+GobEncode encodes both values of the Tuple with encoding/gob, implementing gob.GobEncoder.
+Example: gob.NewEncoder(w).Encode(Pure(1, "hello")) writes the Tuple to w
+*/
+func (tuple Tuple[T1, T2]) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := gob.NewEncoder(&buffer)
+	if err := encoder.Encode(tuple._1); err != nil {
+		return nil, err
+	}
+	if err := encoder.Encode(tuple._2); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+/*
+GobDecode decodes a Tuple encoded by GobEncode into tuple, implementing gob.GobDecoder.
+Example: gob.NewDecoder(r).Decode(&t) reads a Tuple from r into t
+*/
+func (tuple *Tuple[T1, T2]) GobDecode(data []byte) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+	var decoded Tuple[T1, T2]
+	if err := decoder.Decode(&decoded._1); err != nil {
+		return err
+	}
+	if err := decoder.Decode(&decoded._2); err != nil {
+		return err
+	}
+	*tuple = decoded
+	return nil
+}